@@ -0,0 +1,198 @@
+package irapi
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+)
+
+// RecentRace is a summary of one of a member's recent races
+//
+// Use GetSubSessionResult with the SubsessionID for the full results.
+// Unlike CarResult, positions start from one, with zero meaning the position is unknown.
+type RecentRace struct {
+	UserID uint64 `json:"-"`
+
+	SubsessionID uint64 `json:"subsessionID"`
+	SessionID    uint64 `json:"sessionID"`
+	SeasonID     int    `json:"seasonID"`
+	SeriesID     int    `json:"seriesID"`
+	SeriesName   string `json:"seriesName"`
+	RaceWeek     int    `json:"raceWeek"`
+
+	TrackID     uint64 `json:"trackID"`
+	TrackName   string `json:"trackName"`
+	TrackConfig string `json:"trackConfig"`
+	CarID       uint64 `json:"carID"`
+	CarClassID  uint64 `json:"carClassID"`
+
+	StartTime      Timestamp `json:"date"`
+	StartPosition  int       `json:"startPos"`
+	FinishPosition int       `json:"finishPos"`
+	Incidents      int       `json:"incidents"`
+	LapsLed        int       `json:"lapsLed"`
+	SOF            int       `json:"sof"`
+	OldIRating     int       `json:"oldiRating"`
+	NewIRating     int       `json:"newiRating"`
+}
+
+// IRatingChange gets how much the member's iRating changed in the race
+func (r RecentRace) IRatingChange() int {
+	return r.NewIRating - r.OldIRating
+}
+
+// SearchResultData converts the race into the form returned by SearchResults
+func (r RecentRace) SearchResultData() SearchResultData {
+	return SearchResultData{
+		SubsessionID: r.SubsessionID,
+		SessionID:    r.SessionID,
+		SeasonID:     r.SeasonID,
+		SeriesID:     r.SeriesID,
+		RaceWeek:     r.RaceWeek,
+		TrackID:      r.TrackID,
+		CarID:        r.CarID,
+		CarClassID:   r.CarClassID,
+		UserID:       r.UserID,
+		RawStartTime: r.StartTime,
+		StartingPos:  r.StartPosition,
+		FinishPos:    r.FinishPosition,
+		Incidents:    r.Incidents,
+		SOF:          r.SOF,
+	}
+}
+
+// SessionResult converts the race into a SessionResult holding only the member's own result
+//
+// Positions are converted to start from zero, like those of GetSubSessionResult.
+func (r RecentRace) SessionResult() SessionResult {
+	return SessionResult{
+		ID:         r.SubsessionID,
+		SessionID:  r.SessionID,
+		SeasonID:   int64(r.SeasonID),
+		SeriesID:   uint64(r.SeriesID),
+		SeriesName: r.SeriesName,
+		RaceWeek:   uint(r.RaceWeek),
+		TrackID:    r.TrackID,
+		TrackName:  r.TrackName,
+
+		TrackConfigName: r.TrackConfig,
+		SOF:             int16(r.SOF),
+
+		Results: []CarResult{{
+			UserID:         int(r.UserID),
+			CarID:          r.CarID,
+			StartPosition:  zeroBasedPosition(r.StartPosition),
+			FinishPosition: zeroBasedPosition(r.FinishPosition),
+			Incidents:      r.Incidents,
+			OldIRating:     r.OldIRating,
+			NewIRating:     r.NewIRating,
+		}},
+	}
+}
+
+// zeroBasedPosition converts a position starting from one to one starting from zero
+//
+// Unknown positions are kept as zero rather than becoming negative.
+func zeroBasedPosition(pos int) int {
+	if pos > 0 {
+		return pos - 1
+	}
+
+	return pos
+}
+
+// GetLastRaces gets a member's most recent races, newest first
+func (c *IRacing) GetLastRaces(ctx context.Context, userID uint64) ([]RecentRace, error) {
+	ctx, span := c.startSpan(ctx, "GetLastRaces", "irapi.cust_id", userID)
+	defer span.End()
+
+	path := "/memberstats/member/GetLastRacesStats?custid=" + strconv.FormatUint(userID, 10)
+
+	var decoded []RecentRace
+
+	if err := c.json(ctx, http.MethodGet, path, nil, &decoded); err != nil {
+		return nil, span.fail(err)
+	}
+
+	// The decoded rows are copied before being modified, so they are never changed in place
+	races := make([]RecentRace, len(decoded))
+
+	for i, r := range decoded {
+		r.UserID = userID
+		races[i] = r
+	}
+
+	return races, nil
+}
+
+// RecentActivity summarises a member's most recent races
+type RecentActivity struct {
+	Races []RecentRace
+
+	Starts           int
+	Wins             int
+	TopFiveFinishes  int
+	LapsLed          int
+	Incidents        int
+	IRatingChange    int
+	AverageFinish    float64
+	AverageIncidents float64
+}
+
+// GetRecentActivity gets a summary of a member's last n races
+//
+// If n is zero or less, every race returned by GetLastRaces is included.
+func (c *IRacing) GetRecentActivity(ctx context.Context, userID uint64, n int) (*RecentActivity, error) {
+	races, err := c.GetLastRaces(ctx, userID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if n > 0 && n < len(races) {
+		races = races[:n]
+	}
+
+	return summariseRaces(races), nil
+}
+
+// summariseRaces totals up a member's races
+//
+// Races without a known finishing position count as starts, but not towards finishes.
+func summariseRaces(races []RecentRace) *RecentActivity {
+	activity := &RecentActivity{
+		Races:  races,
+		Starts: len(races),
+	}
+
+	finishes, positions := 0, 0
+
+	for _, r := range races {
+		if r.FinishPosition > 0 {
+			if r.FinishPosition == 1 {
+				activity.Wins++
+			}
+
+			if r.FinishPosition <= 5 {
+				activity.TopFiveFinishes++
+			}
+
+			finishes++
+			positions += r.FinishPosition
+		}
+
+		activity.LapsLed += r.LapsLed
+		activity.Incidents += r.Incidents
+		activity.IRatingChange += r.IRatingChange()
+	}
+
+	if finishes > 0 {
+		activity.AverageFinish = float64(positions) / float64(finishes)
+	}
+
+	if activity.Starts > 0 {
+		activity.AverageIncidents = float64(activity.Incidents) / float64(activity.Starts)
+	}
+
+	return activity
+}
@@ -0,0 +1,84 @@
+package irapi
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestRecentRaceSessionResult(t *testing.T) {
+	race := RecentRace{UserID: 1, SubsessionID: 10, StartPosition: 3, FinishPosition: 1}
+
+	r := race.SessionResult()
+
+	if len(r.Results) != 1 {
+		t.Fatalf("Expected 1 result but got %d", len(r.Results))
+	}
+
+	if c := r.Results[0]; c.StartPosition != 2 || c.FinishPosition != 0 || c.UserID != 1 {
+		t.Logf("Expected a win from third converted to start from zero but got %+v", c)
+		t.Fail()
+	}
+
+	// A missing position must not become negative
+	if c := (RecentRace{}).SessionResult().Results[0]; c.FinishPosition != 0 {
+		t.Logf("Expected a missing position to stay zero but got %d", c.FinishPosition)
+		t.Fail()
+	}
+}
+
+func TestGetRecentActivity(t *testing.T) {
+	api := testClient(func(req *http.Request) string {
+		return `[
+			{"finishPos":1,"lapsLed":10,"incidents":2,"oldiRating":2000,"newiRating":2050},
+			{"finishPos":6,"incidents":4,"oldiRating":2050,"newiRating":2020},
+			{"finishPos":0,"incidents":0,"oldiRating":2020,"newiRating":2020},
+			{"finishPos":2,"incidents":8,"oldiRating":1900,"newiRating":2000}
+		]`
+	})
+
+	activity, err := api.GetRecentActivity(context.Background(), 1, 3)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if activity.Starts != 3 || len(activity.Races) != 3 {
+		t.Logf("Expected only the last 3 races but got %d", activity.Starts)
+		t.Fail()
+	}
+
+	if activity.Wins != 1 || activity.TopFiveFinishes != 1 {
+		t.Logf("Expected 1 win and 1 top five, ignoring the missing position, but got %d and %d", activity.Wins, activity.TopFiveFinishes)
+		t.Fail()
+	}
+
+	if activity.AverageFinish != 3.5 {
+		t.Logf("Expected an average finish of 3.5 but got %f", activity.AverageFinish)
+		t.Fail()
+	}
+
+	if activity.AverageIncidents != 2 || activity.LapsLed != 10 || activity.IRatingChange != 20 {
+		t.Logf("Unexpected totals %+v", activity)
+		t.Fail()
+	}
+
+	if activity.Races[0].UserID != 1 {
+		t.Logf("Expected races to be for user 1 but got %d", activity.Races[0].UserID)
+		t.Fail()
+	}
+}
+
+func TestGetLastRacesConcurrently(t *testing.T) {
+	api, release := heldClient(func(req *http.Request) string {
+		return `[{"subsessionID":10,"finishPos":1},{"subsessionID":9,"finishPos":4}]`
+	})
+
+	callCoalesced(api, release, 2, func() {
+		races, err := api.GetLastRaces(context.Background(), 5)
+
+		if err != nil || len(races) != 2 || races[0].UserID != 5 {
+			t.Errorf("Expected 2 races for user 5 but got %+v (%v)", races, err)
+		}
+	})
+}
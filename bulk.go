@@ -0,0 +1,83 @@
+package irapi
+
+import (
+	"context"
+	"sync"
+)
+
+// DefaultBulkWorkers is the number of requests a bulk fetch makes concurrently by default
+const DefaultBulkWorkers = 4
+
+// BulkOptions represents options for fetching many items at once
+type BulkOptions struct {
+	// Workers is the number of requests made concurrently, defaulting to DefaultBulkWorkers
+	Workers int
+}
+
+// BulkSubSessionResult is the outcome of fetching a single subsession as part of GetSubSessionResults
+type BulkSubSessionResult struct {
+	SubSessionID uint64
+	Result       *SessionResult
+	Err          error
+}
+
+// GetSubSessionResults gets the results of many subsessions concurrently
+//
+// Results are sent on the returned channel as they complete, in no particular order,
+// and the channel is closed once every subsession has a result. The channel must be drained.
+//
+// A failed subsession only sets the Err of its own result, the rest of the batch carries on.
+// If the context is cancelled, no new requests are started but those already in flight are allowed to finish,
+// so no response which was fetched is lost. Every subsession which was not fetched is sent with the context's error,
+// so the batch can be resumed. Set a timeout on the HTTP client to bound how long in-flight requests can take.
+//
+// Requests are subject to the client's rate limit, see SetRateLimit.
+func (c *IRacing) GetSubSessionResults(ctx context.Context, ids []uint64, opts *BulkOptions) <-chan BulkSubSessionResult {
+	ctx, span := c.startSpan(ctx, "GetSubSessionResults", "irapi.count", len(ids))
+
+	workers := DefaultBulkWorkers
+
+	if opts != nil && opts.Workers > 0 {
+		workers = opts.Workers
+	}
+
+	jobs := make(chan uint64)
+	results := make(chan BulkSubSessionResult)
+
+	go func() {
+		defer close(jobs)
+
+		for _, id := range ids {
+			jobs <- id
+		}
+	}()
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for id := range jobs {
+				r := BulkSubSessionResult{SubSessionID: id}
+
+				if r.Err = ctx.Err(); r.Err == nil {
+					r.Result, r.Err = c.GetSubSessionResult(detachedContext{ctx}, id)
+				}
+
+				results <- r
+			}
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(results)
+		span.fail(ctx.Err())
+		span.End()
+	}()
+
+	return results
+}
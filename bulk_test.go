@@ -0,0 +1,173 @@
+package irapi
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestBulkWorkers(t *testing.T) {
+	var active, peak int32
+
+	api := testClient(func(req *http.Request) string {
+		n := atomic.AddInt32(&active, 1)
+
+		for {
+			p := atomic.LoadInt32(&peak)
+
+			if n <= p || atomic.CompareAndSwapInt32(&peak, p, n) {
+				break
+			}
+		}
+
+		time.Sleep(20 * time.Millisecond)
+		atomic.AddInt32(&active, -1)
+
+		return `{"subsessionid":` + req.URL.Query().Get("subsessionID") + `}`
+	})
+
+	count := 0
+
+	for r := range api.GetSubSessionResults(context.Background(), []uint64{1, 2, 3, 4, 5, 6}, &BulkOptions{Workers: 2}) {
+		if r.Err != nil || r.Result.ID != r.SubSessionID {
+			t.Logf("Unexpected result %+v", r)
+			t.Fail()
+		}
+
+		count++
+	}
+
+	if count != 6 {
+		t.Logf("Expected 6 results but got %d", count)
+		t.Fail()
+	}
+
+	if peak != 2 {
+		t.Logf("Expected 2 concurrent requests but got %d", peak)
+		t.Fail()
+	}
+}
+
+func TestBulkErrorIsolation(t *testing.T) {
+	api := New(StaticCredentialsProvider("", ""))
+	api.SetHTTP(&http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		id := req.URL.Query().Get("subsessionID")
+		res := jsonResponse(req, `{"subsessionid":`+id+`}`)
+
+		if id == "2" {
+			res.StatusCode = http.StatusInternalServerError
+		}
+
+		return res, nil
+	})})
+
+	results := make(map[uint64]BulkSubSessionResult)
+
+	for r := range api.GetSubSessionResults(context.Background(), []uint64{1, 2, 3}, nil) {
+		results[r.SubSessionID] = r
+	}
+
+	if len(results) != 3 {
+		t.Fatalf("Expected 3 results but got %d", len(results))
+	}
+
+	if r := results[2]; r.Err == nil || r.Result != nil {
+		t.Logf("Expected subsession 2 to fail but got %+v", r)
+		t.Fail()
+	}
+
+	for _, id := range []uint64{1, 3} {
+		if r := results[id]; r.Err != nil || r.Result == nil || r.Result.ID != id {
+			t.Logf("Expected subsession %d to succeed but got %+v", id, r)
+			t.Fail()
+		}
+	}
+}
+
+func TestBulkCancellation(t *testing.T) {
+	var mu sync.Mutex
+	requested := make(map[string]bool)
+	release := make(chan struct{})
+
+	api := testClient(func(req *http.Request) string {
+		id := req.URL.Query().Get("subsessionID")
+
+		mu.Lock()
+		requested[id] = true
+		mu.Unlock()
+
+		if id != "1" {
+			<-release
+		}
+
+		return `{"subsessionid":` + id + `}`
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ids := []uint64{1, 2, 3, 4, 5, 6}
+	results := api.GetSubSessionResults(ctx, ids, &BulkOptions{Workers: 2})
+
+	first := <-results
+
+	if first.SubSessionID != 1 || first.Err != nil {
+		t.Fatalf("Expected subsession 1 first but got %+v", first)
+	}
+
+	// Wait for the next request to be in flight before cancelling
+	for {
+		mu.Lock()
+		n := len(requested)
+		mu.Unlock()
+
+		if n >= 2 {
+			break
+		}
+
+		time.Sleep(time.Millisecond)
+	}
+
+	cancel()
+	close(release)
+
+	delivered := map[uint64]BulkSubSessionResult{1: first}
+
+	for r := range results {
+		delivered[r.SubSessionID] = r
+	}
+
+	if len(delivered) != len(ids) {
+		t.Fatalf("Expected a result for all %d subsessions but got %d", len(ids), len(delivered))
+	}
+
+	fetched := 0
+
+	for id, r := range delivered {
+		mu.Lock()
+		sent := requested[strconv.FormatUint(id, 10)]
+		mu.Unlock()
+
+		switch {
+		case sent && (r.Err != nil || r.Result == nil):
+			t.Logf("Expected the in-flight request for subsession %d to be delivered but got %v", id, r.Err)
+			t.Fail()
+		case !sent && r.Err != context.Canceled:
+			t.Logf("Expected subsession %d to be cancelled but got %+v", id, r)
+			t.Fail()
+		}
+
+		if r.Err == nil {
+			fetched++
+		}
+	}
+
+	if fetched != len(requested) {
+		t.Logf("Expected every one of the %d upstream requests to be delivered but got %d", len(requested), fetched)
+		t.Fail()
+	}
+}
@@ -0,0 +1,325 @@
+package irapi
+
+import (
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"math"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// CacheForever is a TTL for responses which never expire, such as the results of an official subsession
+const CacheForever = time.Duration(math.MaxInt64)
+
+// Cache is a store for raw API responses, keyed by request path
+//
+// Caches must be safe for concurrent use.
+// MemoryCache and DiskCache are provided, other stores like Redis can be plugged in by implementing this interface.
+type Cache interface {
+	// Get returns the value stored for key, if it exists and has not expired
+	Get(key string) ([]byte, bool)
+
+	// Set stores value for key for the duration of ttl
+	Set(key string, value []byte, ttl time.Duration)
+
+	// Delete removes the value stored for key
+	Delete(key string)
+}
+
+// unofficialResultTTL is how long the results of a subsession which isn't official are cached for
+//
+// Results become official a short while after a race finishes, so they are only cached forever once they have.
+const unofficialResultTTL = 10 * time.Minute
+
+// CacheTTLFunc picks how long a response is cached for from its content, or returns false if it shouldn't be cached
+type CacheTTLFunc func(content []byte) (time.Duration, bool)
+
+// fixedTTL gets a CacheTTLFunc which caches every response for the same duration
+func fixedTTL(ttl time.Duration) CacheTTLFunc {
+	return func([]byte) (time.Duration, bool) {
+		return ttl, true
+	}
+}
+
+// subsessionResultTTL caches the results of official subsessions forever, and any others briefly
+func subsessionResultTTL(content []byte) (time.Duration, bool) {
+	var result struct {
+		Official NumericBool `json:"officialsession"`
+	}
+
+	if err := json.Unmarshal(content, &result); err != nil {
+		return 0, false
+	}
+
+	if result.Official {
+		return CacheForever, true
+	}
+
+	return unofficialResultTTL, true
+}
+
+// defaultCacheTTLs gets the TTL for each endpoint which is cached by default
+func defaultCacheTTLs() map[string]CacheTTLFunc {
+	return map[string]CacheTTLFunc{
+		"/membersite/member/GetSubsessionResults": subsessionResultTTL,
+		"/membersite/member/GetSeasons":           fixedTTL(time.Hour),
+		"/membersite/member/GetCars":              fixedTTL(time.Hour),
+		"/membersite/member/GetTracks":            fixedTTL(time.Hour),
+	}
+}
+
+// SetCache sets the cache used for API responses
+//
+// Only GET requests to endpoints with a TTL are cached, see SetCacheTTL.
+func (c *IRacing) SetCache(cache Cache) {
+	c.cache = cache
+}
+
+// SetCacheTTL sets how long responses from the endpoint at path are cached for
+//
+// The path excludes the query string, e.g. `/membersite/member/GetSeasons`.
+// A TTL of zero or less disables caching for the endpoint.
+func (c *IRacing) SetCacheTTL(path string, ttl time.Duration) {
+	if ttl <= 0 {
+		delete(c.cacheTTLs, path)
+		return
+	}
+
+	c.cacheTTLs[path] = fixedTTL(ttl)
+}
+
+// SetCacheTTLFunc sets a function which picks how long each response from the endpoint at path is cached for
+//
+// This allows the TTL to depend on the response, such as caching results forever only once they are official.
+// A nil function disables caching for the endpoint.
+func (c *IRacing) SetCacheTTLFunc(path string, f CacheTTLFunc) {
+	if f == nil {
+		delete(c.cacheTTLs, path)
+		return
+	}
+
+	c.cacheTTLs[path] = f
+}
+
+// cacheTTL gets the function picking the TTL for a request, and if it can be cached at all
+func (c *IRacing) cacheTTL(method, path string) (CacheTTLFunc, bool) {
+	if c.cache == nil || method != http.MethodGet {
+		return nil, false
+	}
+
+	ttl, ok := c.cacheTTLs[strings.SplitN(path, "?", 2)[0]]
+
+	return ttl, ok
+}
+
+// expiry gets the time at which a value stored now with the given ttl expires
+//
+// The zero time is returned for values which never expire.
+func expiry(ttl time.Duration) time.Time {
+	if ttl == CacheForever {
+		return time.Time{}
+	}
+
+	return time.Now().Add(ttl)
+}
+
+type cacheMode int
+
+const (
+	cacheDefault cacheMode = iota
+	cacheBypass
+	cacheRefresh
+)
+
+type cacheModeKey struct{}
+
+// BypassCache returns a context which skips the cache for any calls made with it
+//
+// Responses are neither read from nor written to the cache.
+func BypassCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, cacheModeKey{}, cacheBypass)
+}
+
+// RefreshCache returns a context which ignores cached responses for any calls made with it
+//
+// Fresh responses are still written to the cache, replacing any stale ones.
+func RefreshCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, cacheModeKey{}, cacheRefresh)
+}
+
+func cacheModeFromContext(ctx context.Context) cacheMode {
+	mode, _ := ctx.Value(cacheModeKey{}).(cacheMode)
+	return mode
+}
+
+// MemoryCache is an in-memory Cache which evicts the least recently used entries
+type MemoryCache struct {
+	mu      sync.Mutex
+	size    int
+	order   *list.List
+	entries map[string]*list.Element
+}
+
+type memoryCacheEntry struct {
+	key     string
+	value   []byte
+	expires time.Time
+}
+
+// NewMemoryCache creates a new in-memory cache holding at most size responses
+func NewMemoryCache(size int) *MemoryCache {
+	return &MemoryCache{
+		size:    size,
+		order:   list.New(),
+		entries: make(map[string]*list.Element),
+	}
+}
+
+// Get returns the value stored for key, if it exists and has not expired
+func (m *MemoryCache) Get(key string) ([]byte, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	el, ok := m.entries[key]
+
+	if !ok {
+		return nil, false
+	}
+
+	entry := el.Value.(*memoryCacheEntry)
+
+	if !entry.expires.IsZero() && time.Now().After(entry.expires) {
+		m.remove(el)
+		return nil, false
+	}
+
+	m.order.MoveToFront(el)
+
+	return entry.value, true
+}
+
+// Set stores value for key for the duration of ttl
+func (m *MemoryCache) Set(key string, value []byte, ttl time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if el, ok := m.entries[key]; ok {
+		entry := el.Value.(*memoryCacheEntry)
+		entry.value = value
+		entry.expires = expiry(ttl)
+		m.order.MoveToFront(el)
+		return
+	}
+
+	m.entries[key] = m.order.PushFront(&memoryCacheEntry{
+		key:     key,
+		value:   value,
+		expires: expiry(ttl),
+	})
+
+	for m.order.Len() > m.size {
+		m.remove(m.order.Back())
+	}
+}
+
+// Delete removes the value stored for key
+func (m *MemoryCache) Delete(key string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if el, ok := m.entries[key]; ok {
+		m.remove(el)
+	}
+}
+
+func (m *MemoryCache) remove(el *list.Element) {
+	m.order.Remove(el)
+	delete(m.entries, el.Value.(*memoryCacheEntry).key)
+}
+
+// DiskCache is a Cache which stores responses as files in a directory
+//
+// Each file holds the expiry time of the response followed by its content.
+// Failures to read or write files are treated as cache misses.
+type DiskCache struct {
+	dir string
+}
+
+// NewDiskCache creates a new on-disk cache in dir, creating the directory if needed
+func NewDiskCache(dir string) (*DiskCache, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+
+	return &DiskCache{dir: dir}, nil
+}
+
+// Get returns the value stored for key, if it exists and has not expired
+func (d *DiskCache) Get(key string) ([]byte, bool) {
+	data, err := ioutil.ReadFile(d.path(key))
+
+	if err != nil || len(data) < 8 {
+		return nil, false
+	}
+
+	if expires := int64(binary.BigEndian.Uint64(data)); expires != 0 && time.Now().UnixNano() > expires {
+		d.Delete(key)
+		return nil, false
+	}
+
+	return data[8:], true
+}
+
+// Set stores value for key for the duration of ttl
+func (d *DiskCache) Set(key string, value []byte, ttl time.Duration) {
+	var expires int64
+
+	if t := expiry(ttl); !t.IsZero() {
+		expires = t.UnixNano()
+	}
+
+	data := make([]byte, 8+len(value))
+	binary.BigEndian.PutUint64(data, uint64(expires))
+	copy(data[8:], value)
+
+	// Write to a temporary file first so readers never see a partial response
+	f, err := ioutil.TempFile(d.dir, ".tmp-")
+
+	if err != nil {
+		return
+	}
+
+	_, err = f.Write(data)
+
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+
+	if err != nil {
+		os.Remove(f.Name())
+		return
+	}
+
+	if err := os.Rename(f.Name(), d.path(key)); err != nil {
+		os.Remove(f.Name())
+	}
+}
+
+// Delete removes the value stored for key
+func (d *DiskCache) Delete(key string) {
+	os.Remove(d.path(key))
+}
+
+func (d *DiskCache) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(d.dir, hex.EncodeToString(sum[:]))
+}
@@ -0,0 +1,239 @@
+package irapi
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestMemoryCacheEvictsLeastRecentlyUsed(t *testing.T) {
+	cache := NewMemoryCache(2)
+
+	cache.Set("a", []byte("1"), CacheForever)
+	cache.Set("b", []byte("2"), CacheForever)
+
+	// Touch "a" so that "b" is the least recently used
+	cache.Get("a")
+
+	cache.Set("c", []byte("3"), CacheForever)
+
+	if _, ok := cache.Get("b"); ok {
+		t.Log("Expected 'b' to be evicted")
+		t.Fail()
+	}
+
+	for _, key := range []string{"a", "c"} {
+		if _, ok := cache.Get(key); !ok {
+			t.Logf("Expected '%s' to be cached", key)
+			t.Fail()
+		}
+	}
+}
+
+func TestMemoryCacheExpires(t *testing.T) {
+	cache := NewMemoryCache(10)
+
+	cache.Set("a", []byte("1"), time.Nanosecond)
+	time.Sleep(time.Millisecond)
+
+	if _, ok := cache.Get("a"); ok {
+		t.Log("Expected 'a' to have expired")
+		t.Fail()
+	}
+}
+
+func TestDiskCache(t *testing.T) {
+	dir, err := ioutil.TempDir("", "irapi-cache")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer os.RemoveAll(dir)
+
+	cache, err := NewDiskCache(dir)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cache.Set("forever", []byte("value"), CacheForever)
+	cache.Set("expired", []byte("value"), time.Nanosecond)
+	time.Sleep(time.Millisecond)
+
+	if v, ok := cache.Get("forever"); !ok || string(v) != "value" {
+		t.Logf("Expected 'value' but got '%s' (found: %t)", v, ok)
+		t.Fail()
+	}
+
+	if _, ok := cache.Get("expired"); ok {
+		t.Log("Expected 'expired' to have expired")
+		t.Fail()
+	}
+
+	cache.Delete("forever")
+
+	if _, ok := cache.Get("forever"); ok {
+		t.Log("Expected 'forever' to be deleted")
+		t.Fail()
+	}
+}
+
+// cachingClient creates a client with a memory cache, counting requests to a server which always gives body
+func cachingClient(body string) (*IRacing, *MemoryCache, *int32) {
+	var requests int32
+
+	api := New(StaticCredentialsProvider("", ""))
+	api.SetHTTP(&http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		atomic.AddInt32(&requests, 1)
+		return jsonResponse(req, body), nil
+	})})
+
+	cache := NewMemoryCache(10)
+	api.SetCache(cache)
+
+	return api, cache, &requests
+}
+
+func TestClientCachesResponses(t *testing.T) {
+	api, cache, requests := cachingClient(`[]`)
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		if _, err := api.GetCars(ctx); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if *requests != 1 {
+		t.Logf("Expected 1 upstream request but got %d", *requests)
+		t.Fail()
+	}
+
+	if _, ok := cache.Get("/membersite/member/GetCars"); !ok {
+		t.Log("Expected the response to be cached")
+		t.Fail()
+	}
+}
+
+func TestClientBypassCache(t *testing.T) {
+	api, cache, requests := cachingClient(`[]`)
+	ctx := BypassCache(context.Background())
+
+	cache.Set("/membersite/member/GetCars", []byte(`[{"id":1}]`), CacheForever)
+
+	cars, err := api.GetCars(ctx)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if *requests != 1 || len(cars) != 0 {
+		t.Logf("Expected the cached response to be ignored, but got %d requests and %d cars", *requests, len(cars))
+		t.Fail()
+	}
+
+	if _, err := api.GetTracks(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := cache.Get("/membersite/member/GetTracks"); ok {
+		t.Log("Expected the response not to be cached")
+		t.Fail()
+	}
+}
+
+func TestClientRefreshCache(t *testing.T) {
+	api, cache, requests := cachingClient(`[]`)
+
+	cache.Set("/membersite/member/GetCars", []byte(`[{"id":1}]`), CacheForever)
+
+	cars, err := api.GetCars(RefreshCache(context.Background()))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if *requests != 1 || len(cars) != 0 {
+		t.Logf("Expected the cached response to be ignored, but got %d requests and %d cars", *requests, len(cars))
+		t.Fail()
+	}
+
+	if content, _ := cache.Get("/membersite/member/GetCars"); string(content) != `[]` {
+		t.Logf("Expected the cached response to be replaced but got %s", content)
+		t.Fail()
+	}
+}
+
+func TestClientSkipsCacheForPost(t *testing.T) {
+	api, cache, requests := cachingClient(`{"lapData":[]}`)
+	api.SetCacheTTL("/membersite/member/GetLaps", time.Hour)
+
+	for i := 0; i < 2; i++ {
+		if _, err := api.GetLaps(context.Background(), 1, 2, 0); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if *requests != 2 {
+		t.Logf("Expected 2 upstream requests but got %d", *requests)
+		t.Fail()
+	}
+
+	if cache.order.Len() != 0 {
+		t.Logf("Expected nothing to be cached but got %d entries", cache.order.Len())
+		t.Fail()
+	}
+}
+
+func TestSubsessionResultTTL(t *testing.T) {
+	tests := []struct {
+		content string
+		ttl     time.Duration
+		ok      bool
+	}{
+		{`{"subsessionid":1,"officialsession":1}`, CacheForever, true},
+		{`{"subsessionid":1,"officialsession":0}`, unofficialResultTTL, true},
+		{`{"subsessionid":1}`, unofficialResultTTL, true},
+		{`not json`, 0, false},
+	}
+
+	for _, test := range tests {
+		ttl, ok := subsessionResultTTL([]byte(test.content))
+
+		if ttl != test.ttl || ok != test.ok {
+			t.Logf("Expected TTL %s (%t) for %s but got %s (%t)", test.ttl, test.ok, test.content, ttl, ok)
+			t.Fail()
+		}
+	}
+}
+
+func TestClientCachesOnlyOfficialResultsForever(t *testing.T) {
+	api, cache, _ := cachingClient(`{"subsessionid":1,"officialsession":0}`)
+
+	result, err := api.GetSubSessionResult(context.Background(), 1)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result.Official {
+		t.Log("Expected the result not to be official")
+		t.Fail()
+	}
+
+	el, ok := cache.entries["/membersite/member/GetSubsessionResults?subsessionID=1"]
+
+	if !ok {
+		t.Fatal("Expected the result to be cached")
+	}
+
+	if expires := el.Value.(*memoryCacheEntry).expires; expires.IsZero() || time.Until(expires) > unofficialResultTTL {
+		t.Logf("Expected the unofficial result to expire within %s but got %s", unofficialResultTTL, expires)
+		t.Fail()
+	}
+}
@@ -0,0 +1,128 @@
+package irapi
+
+import (
+	"context"
+	"net/http"
+	"sort"
+)
+
+// Car represents a car in iRacing's catalog
+type Car struct {
+	ID           uint64          `json:"id"`
+	Name         string          `json:"name"`
+	Abbreviation string          `json:"abbrevname"`
+	Category     LicenceCategory `json:"catid"`
+	PackageID    uint64          `json:"pkgid"`
+	Price        float64         `json:"price"`
+	Free         bool            `json:"freeWithSubscription"`
+	Owned        bool            `json:"owned"`
+}
+
+// Track represents a single configuration of a track in iRacing's catalog
+//
+// Each configuration has its own ID, configurations of the same track share a PackageID.
+type Track struct {
+	ID            uint64          `json:"id"`
+	Name          string          `json:"name"`
+	Configuration string          `json:"config"`
+	Category      LicenceCategory `json:"catid"`
+	PackageID     uint64          `json:"pkgid"`
+	LengthMiles   float64         `json:"trackLength"`
+	CornersPerLap uint8           `json:"cornersPerLap"`
+	PitRoadSpeed  float64         `json:"pitRoadSpeedLimit"`
+	Price         float64         `json:"price"`
+	Free          bool            `json:"freeWithSubscription"`
+	Owned         bool            `json:"owned"`
+}
+
+// FullName gets the name of the track including its configuration
+func (t Track) FullName() string {
+	return trackName(t.Name, t.Configuration)
+}
+
+// Cars is iRacing's catalog of cars, by ID
+//
+// IDs are uint64 like those of results, so a result's CarID can be looked up directly.
+type Cars map[uint64]Car
+
+// Name gets the name of a car, or an empty string if it isn't in the catalog
+func (c Cars) Name(carID uint64) string {
+	return c[carID].Name
+}
+
+// Tracks is iRacing's catalog of track configurations, by ID
+//
+// IDs are uint64 like those of results, so a result's TrackID can be looked up directly.
+type Tracks map[uint64]Track
+
+// Name gets the name of a track including its configuration, or an empty string if it isn't in the catalog
+func (t Tracks) Name(trackID uint64) string {
+	track, ok := t[trackID]
+
+	if !ok {
+		return ""
+	}
+
+	return track.FullName()
+}
+
+// Configurations gets every configuration of the track in a package, ordered by ID
+func (t Tracks) Configurations(packageID uint64) []Track {
+	var configs []Track
+
+	for _, track := range t {
+		if track.PackageID == packageID {
+			configs = append(configs, track)
+		}
+	}
+
+	sort.Slice(configs, func(i, j int) bool {
+		return configs[i].ID < configs[j].ID
+	})
+
+	return configs
+}
+
+// GetCars gets the catalog of every car in iRacing
+//
+// The Owned flags are for the current user.
+func (c *IRacing) GetCars(ctx context.Context) (Cars, error) {
+	ctx, span := c.startSpan(ctx, "GetCars")
+	defer span.End()
+
+	var list []Car
+
+	if err := c.json(ctx, http.MethodGet, "/membersite/member/GetCars", nil, &list); err != nil {
+		return nil, span.fail(err)
+	}
+
+	cars := make(Cars, len(list))
+
+	for _, car := range list {
+		cars[car.ID] = car
+	}
+
+	return cars, nil
+}
+
+// GetTracks gets the catalog of every track configuration in iRacing
+//
+// The Owned flags are for the current user.
+func (c *IRacing) GetTracks(ctx context.Context) (Tracks, error) {
+	ctx, span := c.startSpan(ctx, "GetTracks")
+	defer span.End()
+
+	var list []Track
+
+	if err := c.json(ctx, http.MethodGet, "/membersite/member/GetTracks", nil, &list); err != nil {
+		return nil, span.fail(err)
+	}
+
+	tracks := make(Tracks, len(list))
+
+	for _, track := range list {
+		tracks[track.ID] = track
+	}
+
+	return tracks, nil
+}
@@ -0,0 +1,86 @@
+package irapi
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestGetCars(t *testing.T) {
+	api := testClient(func(req *http.Request) string {
+		return `[
+			{"id":1,"name":"Skip Barber Formula 2000","abbrevname":"SBRS","catid":2,"pkgid":10,"freeWithSubscription":true},
+			{"id":2,"name":"Legends Ford '34 Coupe","catid":1,"pkgid":20,"price":11.95,"owned":true}
+		]`
+	})
+
+	cars, err := api.GetCars(context.Background())
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(cars) != 2 {
+		t.Fatalf("Expected 2 cars but got %d", len(cars))
+	}
+
+	if car := cars[1]; car.Abbreviation != "SBRS" || car.Category != LicenceCategoryRoad || !car.Free || car.Owned {
+		t.Logf("Unexpected car %+v", car)
+		t.Fail()
+	}
+
+	// Results use uint64 IDs, which must be usable with the catalog as-is
+	result := SearchResultData{CarID: 2}
+
+	if name := cars.Name(result.CarID); name != "Legends Ford '34 Coupe" {
+		t.Logf("Expected the Legends car but got '%s'", name)
+		t.Fail()
+	}
+
+	car := CarResult{CarID: 2}
+
+	if name := cars.Name(car.CarID); name != "Legends Ford '34 Coupe" {
+		t.Logf("Expected the Legends car for a car result but got '%s'", name)
+		t.Fail()
+	}
+
+	if name := cars.Name(3); name != "" {
+		t.Logf("Expected no name for an unknown car but got '%s'", name)
+		t.Fail()
+	}
+}
+
+func TestGetTracks(t *testing.T) {
+	api := testClient(func(req *http.Request) string {
+		return `[
+			{"id":3,"name":"Lime Rock Park","config":"Full Course","pkgid":30,"trackLength":1.53},
+			{"id":1,"name":"Lime Rock Park","config":"Classic","pkgid":30},
+			{"id":2,"name":"Charlotte Motor Speedway","pkgid":40}
+		]`
+	})
+
+	tracks, err := api.GetTracks(context.Background())
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result := SessionResult{TrackID: 3}
+
+	if name := tracks.Name(result.TrackID); name != "Lime Rock Park - Full Course" {
+		t.Logf("Expected Lime Rock Park - Full Course but got '%s'", name)
+		t.Fail()
+	}
+
+	if name := tracks.Name(2); name != "Charlotte Motor Speedway" {
+		t.Logf("Expected a track without a configuration but got '%s'", name)
+		t.Fail()
+	}
+
+	configs := tracks.Configurations(30)
+
+	if len(configs) != 2 || configs[0].ID != 1 || configs[1].ID != 3 {
+		t.Logf("Expected configurations 1 and 3 of Lime Rock Park but got %+v", configs)
+		t.Fail()
+	}
+}
@@ -0,0 +1,92 @@
+package irapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// ChartType is an enum of the member history charts iRacing provides
+type ChartType int
+
+const (
+	ChartTypeIRating ChartType = iota + 1
+	ChartTypeTTRating
+	ChartTypeLicence
+)
+
+func (t ChartType) String() string {
+	switch t {
+	case ChartTypeIRating:
+		return "iRating"
+	case ChartTypeTTRating:
+		return "TT Rating"
+	case ChartTypeLicence:
+		return "Licence"
+	default:
+		return fmt.Sprintf("Invalid ChartType: %d", t)
+	}
+}
+
+// ChartPoint is a single value of a member's history chart
+//
+// For licence charts the value is iRacing's combined licence class and safety rating, see SafetyRating.
+type ChartPoint struct {
+	Time  Timestamp
+	Value int64
+}
+
+// SafetyRating decodes the value of a licence chart point
+//
+// The value holds the licence class in thousands and the safety rating in hundredths, so 4349 is B 3.49.
+func (p ChartPoint) SafetyRating() SafetyRating {
+	return SafetyRating{Class: LicenceClass(p.Value / 1000), Rating: int(p.Value % 1000)}
+}
+
+// UnmarshalJSON decodes a ChartPoint from its JSON form, a `[timestamp, value]` pair
+func (p *ChartPoint) UnmarshalJSON(b []byte) error {
+	var pair []json.RawMessage
+
+	if err := json.Unmarshal(b, &pair); err != nil {
+		return err
+	}
+
+	if len(pair) != 2 {
+		return fmt.Errorf("expected a [time, value] pair, got %d values", len(pair))
+	}
+
+	if err := json.Unmarshal(pair[0], &p.Time); err != nil {
+		return err
+	}
+
+	return json.Unmarshal(pair[1], &p.Value)
+}
+
+// MarshalJSON encodes a ChartPoint as a `[timestamp, value]` pair
+func (p ChartPoint) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]interface{}{p.Time, p.Value})
+}
+
+// GetChartData gets the history of a member's iRating, TT rating or licence in a category, oldest first
+func (c *IRacing) GetChartData(ctx context.Context, userID uint64, category LicenceCategory, chartType ChartType) ([]ChartPoint, error) {
+	ctx, span := c.startSpan(ctx, "GetChartData", "irapi.cust_id", userID, "irapi.chart_type", chartType.String())
+	defer span.End()
+
+	params := make(url.Values)
+	params.Set("custId", strconv.FormatUint(userID, 10))
+	params.Set("catId", strconv.Itoa(int(category)))
+	params.Set("chartType", strconv.Itoa(int(chartType)))
+
+	path := "/memberstats/member/GetChartData?" + params.Encode()
+
+	var points []ChartPoint
+
+	if err := c.json(ctx, http.MethodGet, path, nil, &points); err != nil {
+		return nil, span.fail(err)
+	}
+
+	return points, nil
+}
@@ -0,0 +1,40 @@
+package irapi
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestGetChartData(t *testing.T) {
+	api := testClient(func(req *http.Request) string {
+		q := req.URL.Query()
+
+		if req.URL.Path != "/memberstats/member/GetChartData" || q.Get("custId") != "123" || q.Get("catId") != "2" || q.Get("chartType") != "3" {
+			t.Errorf("Unexpected request to %s", req.URL)
+		}
+
+		return `[[1600000000000,4349],[1600086400000,4412]]`
+	})
+
+	points, err := api.GetChartData(context.Background(), 123, LicenceCategoryRoad, ChartTypeLicence)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(points) != 2 {
+		t.Fatalf("Expected 2 points but got %d", len(points))
+	}
+
+	if p := points[0]; !time.Time(p.Time).Equal(time.Unix(1600000000, 0)) || p.Value != 4349 {
+		t.Logf("Unexpected first point %+v", p)
+		t.Fail()
+	}
+
+	if sr := points[1].SafetyRating(); sr.Class != LicenceClassB || sr.Rating != 412 {
+		t.Logf("Expected B 4.12 but got %+v", sr)
+		t.Fail()
+	}
+}
@@ -0,0 +1,129 @@
+package irapi
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"sync"
+	"time"
+)
+
+// flightGroup tracks in-flight GET requests so identical concurrent calls share one upstream request
+type flightGroup struct {
+	mu      sync.Mutex
+	flights map[string]*flight
+}
+
+// flight is a single upstream request which one or more callers are waiting on
+type flight struct {
+	done    chan struct{}
+	cancel  context.CancelFunc
+	waiters int
+
+	content []byte
+	value   reflect.Value
+	claimed bool
+	err     error
+}
+
+func newFlightGroup() *flightGroup {
+	return &flightGroup{flights: make(map[string]*flight)}
+}
+
+// coalesce fetches and decodes the response for a GET request to path into `into`,
+// joining an identical request which is already in flight if there is one.
+//
+// The upstream request is only cancelled once every caller waiting on it has given up,
+// each caller's context only cancels its own wait.
+// The first caller to return takes the decoded result, every other caller decodes its own copy of the response,
+// so callers may modify what they get back.
+// store is called with the raw response once it has been decoded successfully.
+func (c *IRacing) coalesce(ctx context.Context, path string, into interface{}, store func([]byte)) error {
+	target := reflect.ValueOf(into)
+	g := c.flights
+
+	g.mu.Lock()
+
+	f, ok := g.flights[path]
+
+	if !ok {
+		fctx, cancel := context.WithCancel(detachedContext{ctx})
+
+		f = &flight{
+			done:   make(chan struct{}),
+			cancel: cancel,
+			value:  reflect.New(target.Type().Elem()),
+		}
+
+		g.flights[path] = f
+
+		go func() {
+			defer cancel()
+
+			f.content, f.err = c.fetch(fctx, http.MethodGet, path, nil)
+
+			if f.err == nil {
+				f.err = c.decode(fctx, path, f.content, f.value.Interface())
+			}
+
+			if f.err == nil {
+				store(f.content)
+			}
+
+			g.mu.Lock()
+			if g.flights[path] == f {
+				delete(g.flights, path)
+			}
+			g.mu.Unlock()
+
+			close(f.done)
+		}()
+	}
+
+	f.waiters++
+
+	g.mu.Unlock()
+
+	select {
+	case <-f.done:
+	case <-ctx.Done():
+		g.mu.Lock()
+		f.waiters--
+
+		if f.waiters == 0 {
+			f.cancel()
+
+			if g.flights[path] == f {
+				delete(g.flights, path)
+			}
+		}
+		g.mu.Unlock()
+
+		return ctx.Err()
+	}
+
+	if f.err != nil {
+		return f.err
+	}
+
+	g.mu.Lock()
+	claim := !f.claimed && f.value.Type() == target.Type()
+	f.claimed = f.claimed || claim
+	g.mu.Unlock()
+
+	if claim {
+		target.Elem().Set(f.value.Elem())
+		return nil
+	}
+
+	return c.decode(ctx, path, f.content, into)
+}
+
+// detachedContext carries the values of its parent context, but not its deadline or cancellation
+type detachedContext struct {
+	context.Context
+}
+
+func (detachedContext) Deadline() (time.Time, bool) { return time.Time{}, false }
+func (detachedContext) Done() <-chan struct{}       { return nil }
+func (detachedContext) Err() error                  { return nil }
@@ -0,0 +1,185 @@
+package irapi
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+type roundTripFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func jsonResponse(req *http.Request, body string) *http.Response {
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     make(http.Header),
+		Body:       ioutil.NopCloser(strings.NewReader(body)),
+		Request:    req,
+	}
+}
+
+// testClient creates a client whose requests are answered by respond, which gives the body of the response
+func testClient(respond func(req *http.Request) string) *IRacing {
+	api := New(StaticCredentialsProvider("", ""))
+	api.SetHTTP(&http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		return jsonResponse(req, respond(req)), nil
+	})})
+
+	return api
+}
+
+func TestCoalesceSharesRequest(t *testing.T) {
+	var requests int32
+	release := make(chan struct{})
+
+	api := New(StaticCredentialsProvider("", ""))
+	api.SetHTTP(&http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		atomic.AddInt32(&requests, 1)
+		<-release
+		return jsonResponse(req, `{"subsessionid":1234}`), nil
+	})})
+
+	var wg sync.WaitGroup
+	results := make([]*SessionResult, 5)
+
+	for i := range results {
+		wg.Add(1)
+
+		go func(i int) {
+			defer wg.Done()
+			results[i], _ = api.GetSubSessionResult(context.Background(), 1234)
+		}(i)
+	}
+
+	// Wait for every caller to join the flight before letting the request complete
+	for {
+		api.flights.mu.Lock()
+		f := api.flights.flights["/membersite/member/GetSubsessionResults?subsessionID=1234"]
+		joined := f != nil && f.waiters == len(results)
+		api.flights.mu.Unlock()
+
+		if joined {
+			break
+		}
+	}
+
+	close(release)
+	wg.Wait()
+
+	if requests != 1 {
+		t.Logf("Expected 1 upstream request but got %d", requests)
+		t.Fail()
+	}
+
+	for _, r := range results {
+		if r == nil || r.ID != 1234 {
+			t.Logf("Expected result for subsession 1234 but got %+v", r)
+			t.Fail()
+		}
+	}
+}
+
+func TestCoalesceCancelledWaiter(t *testing.T) {
+	release := make(chan struct{})
+
+	api := New(StaticCredentialsProvider("", ""))
+	api.SetHTTP(&http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		<-release
+		return jsonResponse(req, `{"subsessionid":1234}`), nil
+	})})
+
+	done := make(chan *SessionResult)
+
+	go func() {
+		r, _ := api.GetSubSessionResult(context.Background(), 1234)
+		done <- r
+	}()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := api.GetSubSessionResult(ctx, 1234); err != context.Canceled {
+		t.Logf("Expected context.Canceled but got %v", err)
+		t.Fail()
+	}
+
+	close(release)
+
+	if r := <-done; r == nil || r.ID != 1234 {
+		t.Logf("Expected the uncancelled caller to get a result but got %+v", r)
+		t.Fail()
+	}
+}
+
+// heldClient creates a client whose responses are held until the returned channel is closed
+func heldClient(respond func(req *http.Request) string) (*IRacing, chan struct{}) {
+	release := make(chan struct{})
+
+	api := testClient(func(req *http.Request) string {
+		<-release
+		return respond(req)
+	})
+
+	return api, release
+}
+
+// callCoalesced makes n concurrent calls with a client from heldClient, releasing its responses
+// once every call has joined the same flight, and waits for them all to return
+func callCoalesced(api *IRacing, release chan struct{}, n int, call func()) {
+	var wg sync.WaitGroup
+
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+			call()
+		}()
+	}
+
+	for joined := false; !joined; {
+		api.flights.mu.Lock()
+		for _, f := range api.flights.flights {
+			joined = joined || f.waiters == n
+		}
+		api.flights.mu.Unlock()
+	}
+
+	close(release)
+	wg.Wait()
+}
+
+func TestCoalesceCopiesResult(t *testing.T) {
+	api, release := heldClient(func(req *http.Request) string {
+		return `[{"league_season_id":1,"league_season_name":"Season 1"}]`
+	})
+
+	var mu sync.Mutex
+	var results [][]LeagueSeason
+
+	callCoalesced(api, release, 2, func() {
+		seasons, _ := api.GetLeagueSeasons(context.Background(), 100)
+
+		mu.Lock()
+		results = append(results, seasons)
+		mu.Unlock()
+	})
+
+	if len(results[0]) != 1 || len(results[1]) != 1 {
+		t.Fatalf("Expected 1 season for each caller but got %+v", results)
+	}
+
+	results[0][0].Name = "Modified"
+
+	if results[1][0].Name != "Season 1" {
+		t.Logf("Expected each caller to get its own copy of the result but got %+v", results[1])
+		t.Fail()
+	}
+}
@@ -5,7 +5,6 @@ import (
 	"flag"
 	"fmt"
 	"log"
-	"net/http"
 	"os"
 	"text/tabwriter"
 	"time"
@@ -38,21 +37,7 @@ func main() {
 	api := irapi.New(creds)
 
 	if *debug {
-
-		api.BeforeRequest(func(ctx context.Context, req *http.Request) error {
-
-			return nil
-		})
-
-		api.AfterResponse(func(_ context.Context, req *http.Request, res *http.Response) error {
-
-			log.Println("Response")
-			res.Write(log.Writer())
-
-			log.Println()
-
-			return nil
-		})
+		api.SetLogger(irapi.NewStdLogger(log.New(os.Stderr, "", log.LstdFlags)))
 	}
 
 	log.Println("Created API")
@@ -5,7 +5,6 @@ import (
 	"flag"
 	"fmt"
 	"log"
-	"net/http"
 	"os"
 
 	"github.com/leoadamek/irapi"
@@ -39,20 +38,7 @@ func main() {
 	api := irapi.New(creds)
 
 	if *debug {
-		api.BeforeRequest(func(_ context.Context, req *http.Request) error {
-
-			log.Println("Request")
-			req.Header.Write(os.Stdout)
-			return nil
-		})
-
-		api.AfterResponse(func(_ context.Context, _ *http.Request, res *http.Response) error {
-			log.Println("Response")
-			log.Println(res.Status)
-			res.Header.Write(os.Stdout)
-			res.Write(os.Stdout)
-			return nil
-		})
+		api.SetLogger(irapi.NewStdLogger(log.New(os.Stderr, "", log.LstdFlags)))
 	}
 
 	laps, err := api.GetLaps(ctx, *sid, *eid, *ph)
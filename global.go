@@ -1,16 +1,60 @@
 package irapi
 
-import "context"
+import (
+	"context"
+	"net/http"
+)
 
-type GlobalStatsResponse struct {
-	DriverCounts struct {
-		Total    uint64
-		LapCount StringifiedUint64
+// GlobalStats represents platform-wide statistics for the iRacing service
+type GlobalStats struct {
+	TotalDrivers  StringifiedUint64   `json:"total"`
+	TotalLaps     StringifiedUint64   `json:"lapcount"`
+	ActiveDrivers []ActiveDriverCount `json:"active"`
+}
+
+// ActiveDriverCount is the number of active drivers holding a licence class in a category
+type ActiveDriverCount struct {
+	Category LicenceCategory   `json:"catid"`
+	Class    LicenceClass      `json:"licgroup"`
+	Count    StringifiedUint64 `json:"count"`
+}
+
+// ActiveDriversInCategory gets the number of active drivers in a category, across all licence classes
+func (g GlobalStats) ActiveDriversInCategory(category LicenceCategory) uint64 {
+	var n uint64
+
+	for _, a := range g.ActiveDrivers {
+		if a.Category == category {
+			n += uint64(a.Count)
+		}
 	}
+
+	return n
 }
 
-func (i *IRacing) GlobalStats(ctx context.Context) {
+// ActiveDriversWithClass gets the number of active drivers holding a licence class, across all categories
+func (g GlobalStats) ActiveDriversWithClass(class LicenceClass) uint64 {
+	var n uint64
 
-	// path := "/membersite/member/GetDriverCounts"
+	for _, a := range g.ActiveDrivers {
+		if a.Class == class {
+			n += uint64(a.Count)
+		}
+	}
+
+	return n
+}
+
+// GlobalStats gets the platform-wide driver counts and total laps driven
+func (c *IRacing) GlobalStats(ctx context.Context) (*GlobalStats, error) {
+	ctx, span := c.startSpan(ctx, "GlobalStats")
+	defer span.End()
+
+	stats := &GlobalStats{}
+
+	if err := c.json(ctx, http.MethodGet, "/membersite/member/GetDriverCounts", nil, stats); err != nil {
+		return nil, span.fail(err)
+	}
 
+	return stats, nil
 }
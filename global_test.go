@@ -0,0 +1,51 @@
+package irapi
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestGlobalStats(t *testing.T) {
+	api := testClient(func(req *http.Request) string {
+		if req.URL.Path != "/membersite/member/GetDriverCounts" {
+			t.Errorf("Unexpected request to %s", req.URL)
+		}
+
+		return `{
+			"total":"112,345",
+			"lapcount":"1,234,567,890",
+			"active":[
+				{"catid":2,"licgroup":4,"count":"10,500"},
+				{"catid":2,"licgroup":5,"count":"2,250"},
+				{"catid":1,"licgroup":4,"count":"3,000"}
+			]
+		}`
+	})
+
+	stats, err := api.GlobalStats(context.Background())
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if stats.TotalDrivers != 112345 || stats.TotalLaps != 1234567890 {
+		t.Logf("Unexpected totals %+v", stats)
+		t.Fail()
+	}
+
+	if n := stats.ActiveDriversInCategory(LicenceCategoryRoad); n != 12750 {
+		t.Logf("Expected 12750 active road drivers but got %d", n)
+		t.Fail()
+	}
+
+	if n := stats.ActiveDriversWithClass(LicenceClassB); n != 13500 {
+		t.Logf("Expected 13500 active B licence holders but got %d", n)
+		t.Fail()
+	}
+
+	if n := stats.ActiveDriversInCategory(LicenceCategoryDirtRoad); n != 0 {
+		t.Logf("Expected no active dirt road drivers but got %d", n)
+		t.Fail()
+	}
+}
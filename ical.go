@@ -0,0 +1,167 @@
+package irapi
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// CalendarOptions represents options for exporting season schedules as an iCalendar feed
+type CalendarOptions struct {
+	// Name is the name of the calendar shown by calendar apps
+	Name string
+
+	// Sessions adds an event for each race guide session of the exported seasons
+	Sessions []RaceGuideEntry
+
+	// SessionLength is the length of session events, defaulting to one hour
+	SessionLength time.Duration
+
+	// Now is the time the feed is created, defaulting to the current time
+	Now time.Time
+}
+
+const (
+	icalDateFormat     = "20060102"
+	icalDateTimeFormat = "20060102T150405Z"
+	icalLineLength     = 75
+)
+
+var icalEscaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\n", `\n`)
+
+// WriteCalendar writes the weekly track rotation of seasons as an RFC 5545 iCalendar feed
+//
+// Each race week is written as an all-day event lasting the week, with a UID which
+// stays the same for a season and week so calendar apps update rather than duplicate it.
+// opts may be nil.
+func WriteCalendar(w io.Writer, seasons []Season, opts *CalendarOptions) error {
+	if opts == nil {
+		opts = &CalendarOptions{}
+	}
+
+	now := opts.Now
+
+	if now.IsZero() {
+		now = time.Now()
+	}
+
+	length := opts.SessionLength
+
+	if length <= 0 {
+		length = time.Hour
+	}
+
+	cw := &calendarWriter{w: bufio.NewWriter(w), stamp: now.UTC().Format(icalDateTimeFormat)}
+
+	cw.line("BEGIN:VCALENDAR")
+	cw.line("VERSION:2.0")
+	cw.line("PRODID:-//irapi//iRacing Schedules//EN")
+	cw.line("CALSCALE:GREGORIAN")
+
+	if opts.Name != "" {
+		cw.text("X-WR-CALNAME", opts.Name)
+	}
+
+	included := make(map[int]bool, len(seasons))
+
+	for _, s := range seasons {
+		included[s.SeasonID] = true
+
+		start := time.Time(s.Start).UTC()
+
+		for _, t := range s.Tracks {
+			weekStart := start.Add(time.Duration(t.RaceWeek) * raceWeekLength)
+
+			cw.line("BEGIN:VEVENT")
+			cw.line(fmt.Sprintf("UID:season-%d-week-%d@irapi", s.SeasonID, t.RaceWeek))
+			cw.line("DTSTAMP:" + cw.stamp)
+			cw.line("DTSTART;VALUE=DATE:" + weekStart.Format(icalDateFormat))
+			cw.line("DTEND;VALUE=DATE:" + weekStart.Add(raceWeekLength).Format(icalDateFormat))
+			cw.text("SUMMARY", fmt.Sprintf("%s: %s", s.ShortName, trackName(t.Name, t.Configuration)))
+			cw.text("DESCRIPTION", fmt.Sprintf("%d Season %d, Week %d", s.Year, s.Quarter, t.RaceWeek+1))
+			cw.line("TRANSP:TRANSPARENT")
+			cw.line("END:VEVENT")
+		}
+	}
+
+	for _, e := range opts.Sessions {
+		if !included[e.SeasonID] {
+			continue
+		}
+
+		start := time.Time(e.StartTime).UTC()
+		summary := e.SeriesName
+
+		if e.Track != nil {
+			summary += ": " + trackName(e.Track.Name, e.Track.Configuration)
+		}
+
+		cw.line("BEGIN:VEVENT")
+		cw.line(fmt.Sprintf("UID:season-%d-session-%d@irapi", e.SeasonID, start.Unix()))
+		cw.line("DTSTAMP:" + cw.stamp)
+		cw.line("DTSTART:" + start.Format(icalDateTimeFormat))
+		cw.line("DTEND:" + start.Add(length).Format(icalDateTimeFormat))
+		cw.text("SUMMARY", summary)
+		cw.line("END:VEVENT")
+	}
+
+	cw.line("END:VCALENDAR")
+
+	if cw.err != nil {
+		return cw.err
+	}
+
+	return cw.w.Flush()
+}
+
+func trackName(name, config string) string {
+	if config == "" {
+		return name
+	}
+
+	return name + " - " + config
+}
+
+// calendarWriter writes the content lines of an iCalendar feed, keeping the first error
+type calendarWriter struct {
+	w     *bufio.Writer
+	stamp string
+	err   error
+}
+
+// text writes a property with a text value, escaping it
+func (c *calendarWriter) text(name, value string) {
+	c.line(name + ":" + icalEscaper.Replace(value))
+}
+
+// line writes a content line, folding it so no line is longer than 75 octets
+func (c *calendarWriter) line(s string) {
+	if c.err != nil {
+		return
+	}
+
+	limit := icalLineLength
+
+	for len(s) > limit {
+		// Don't split a multi-byte character across lines
+		i := limit
+
+		for i > 0 && !utf8.RuneStart(s[i]) {
+			i--
+		}
+
+		if _, c.err = c.w.WriteString(s[:i] + "\r\n "); c.err != nil {
+			return
+		}
+
+		s = s[i:]
+
+		// Continuation lines start with a space, which counts towards their length
+		limit = icalLineLength - 1
+	}
+
+	_, c.err = c.w.WriteString(s + "\r\n")
+}
@@ -0,0 +1,64 @@
+package irapi
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWriteCalendar(t *testing.T) {
+	start := time.Date(2020, 12, 8, 0, 0, 0, 0, time.UTC)
+
+	seasons := []Season{{
+		SeasonID:  3001,
+		ShortName: "Advanced Mazda MX-5 Cup Series",
+		Year:      2021,
+		Quarter:   1,
+		Start:     Timestamp(start),
+		End:       Timestamp(start.Add(12 * raceWeekLength)),
+		Tracks: []SeasonTrack{
+			{ID: 1, Name: "Okayama International Circuit", Configuration: "Full Course", RaceWeek: 0},
+			{ID: 2, Name: "Lime Rock Park", RaceWeek: 1},
+		},
+	}}
+
+	buf := new(bytes.Buffer)
+
+	if err := WriteCalendar(buf, seasons, &CalendarOptions{Now: start}); err != nil {
+		t.Fatal(err)
+	}
+
+	out := buf.String()
+
+	expected := []string{
+		"BEGIN:VCALENDAR\r\n",
+		"UID:season-3001-week-0@irapi\r\n",
+		"DTSTART;VALUE=DATE:20201208\r\nDTEND;VALUE=DATE:20201215\r\n",
+		"UID:season-3001-week-1@irapi\r\n",
+		"DTSTART;VALUE=DATE:20201215\r\n",
+		`DESCRIPTION:2021 Season 1\, Week 2` + "\r\n",
+		"END:VCALENDAR\r\n",
+	}
+
+	for _, e := range expected {
+		if !strings.Contains(out, e) {
+			t.Logf("Expected calendar to contain %q", e)
+			t.Fail()
+		}
+	}
+
+	for _, line := range strings.Split(out, "\r\n") {
+		if len(line) > icalLineLength {
+			t.Logf("Expected lines to be folded at %d octets but got %q", icalLineLength, line)
+			t.Fail()
+		}
+	}
+
+	unfolded := strings.ReplaceAll(out, "\r\n ", "")
+
+	if !strings.Contains(unfolded, "SUMMARY:Advanced Mazda MX-5 Cup Series: Okayama International Circuit - Full Course\r\n") {
+		t.Log("Expected the folded summary to unfold to the full track name")
+		t.Fail()
+	}
+}
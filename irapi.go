@@ -11,8 +11,9 @@ import (
 	"net/http"
 	"net/http/cookiejar"
 	"net/url"
-	"os"
 	"strings"
+	"sync/atomic"
+	"time"
 )
 
 // UserAgent is the value given for the User-Agent
@@ -35,25 +36,36 @@ var (
 type IRacing struct {
 	http                *http.Client
 	credentialsProvider CredentialsProvider
-	BeforeFuncs         []BeforeFunc
-	AfterFuncs          []AfterFunc
+	middleware          []Middleware
+
+	cache     Cache
+	cacheTTLs map[string]CacheTTLFunc
+	flights   *flightGroup
+	limiter   *rateLimiter
+	retries   int
+	logger    Logger
+	metrics   Metrics
+	tracer    Tracer
+	loggedIn  int32
 }
 
 // BeforeFunc is a function which runs before a request is sent
 //
-// These can be used with `IRacing.BeforeRequest()` to add middleware before a request is made.
+// These can be used with `IRacing.BeforeRequest()` or `Before()` to add middleware before a request is made.
 // BeforeFunc handlers are responsible for preserving the content of `req.Body` if they consume it.
 type BeforeFunc func(ctx context.Context, req *http.Request) error
 
 // AfterFunc is a function which is fun after a response is received
 //
-// These can be used with `IRacing.AfterResponse()` to add middleware after a response is received.
+// These can be used with `IRacing.AfterResponse()` or `After()` to add middleware after a response is received.
 // AfterFunc handlers are responsible for preserving the content of `res.Body` if they consume it.
 type AfterFunc func(ctx context.Context, req *http.Request, res *http.Response) error
 
 // Host is the address where the iRacing service is hosted
 const Host = "https://members.iracing.com"
 
+const loginPath = "/membersite/Login"
+
 // New crates a new iRacing API client instance
 func New(credentials CredentialsProvider) *IRacing {
 
@@ -73,17 +85,22 @@ func New(credentials CredentialsProvider) *IRacing {
 	return &IRacing{
 		http:                client,
 		credentialsProvider: credentials,
+		cacheTTLs:           defaultCacheTTLs(),
+		flights:             newFlightGroup(),
+		logger:              nopLogger{},
+		metrics:             nopMetrics{},
+		tracer:              nopTracer{},
 	}
 }
 
-// BeforeRequest adds a new BeforeFunc to the chain
+// BeforeRequest adds a new BeforeFunc to the middleware chain
 func (c *IRacing) BeforeRequest(f BeforeFunc) {
-	c.BeforeFuncs = append(c.BeforeFuncs, f)
+	c.Use(Before(f))
 }
 
-// AfterResponse adds a new AfterFunc to the response chain
+// AfterResponse adds a new AfterFunc to the middleware chain
 func (c *IRacing) AfterResponse(f AfterFunc) {
-	c.AfterFuncs = append(c.AfterFuncs, f)
+	c.Use(After(f))
 }
 
 // SetHTTP overrides the HTTP client for the API instance
@@ -92,25 +109,71 @@ func (c *IRacing) SetHTTP(client *http.Client) {
 }
 
 // do run an HTTP Request
-func (c IRacing) do(ctx context.Context, req *http.Request) (*http.Response, error) {
+func (c *IRacing) do(ctx context.Context, req *http.Request) (*http.Response, error) {
+	ctx, span := c.startSpan(ctx, "request", "http.method", req.Method, "http.path", req.URL.Path)
+	defer span.End()
+
+	req = req.WithContext(ctx)
 
 	req.Header.Set("User-Agent", UserAgent)
 	req.Header.Set("Cache-Control", "no-cache")
 	req.Header.Set("Origin", "members.iracing.com")
 	req.Header.Set("Referer", Host+"/membersite/login.jsp")
 
-	for _, f := range c.BeforeFuncs {
-		if err := f(ctx, req); err != nil {
+	if tp := span.TraceParent(); tp != "" {
+		req.Header.Set("traceparent", tp)
+	}
+
+	res, err := c.handler()(ctx, req)
+
+	if res != nil {
+		span.SetAttribute("http.status_code", res.StatusCode)
+	}
+
+	return res, span.fail(err)
+}
+
+// logRequest logs the outcome of a request once it has been sent
+func (c *IRacing) logRequest(req *http.Request, res *http.Response, err error, duration time.Duration, retries int) {
+	status := 0
+
+	if res != nil {
+		status = res.StatusCode
+	}
+
+	args := []interface{}{
+		"method", req.Method,
+		"path", req.URL.Path,
+		"status", status,
+		"duration", duration,
+		"retries", retries,
+	}
+
+	if err != nil {
+		c.logger.Error("request failed", append(args, "error", err)...)
+		return
+	}
+
+	c.logger.Debug("request", append(args,
+		"headers", redactHeaders(req.Header),
+		"body", redactBody(req),
+	)...)
+}
+
+// send sends a single HTTP request, waiting for the rate limiter first
+func (c *IRacing) send(ctx context.Context, req *http.Request) (*http.Response, error) {
+	if c.limiter != nil {
+		if err := c.limiter.wait(ctx); err != nil {
 			return nil, err
 		}
 	}
 
+	start := time.Now()
 	res, err := c.http.Do(req)
+	c.recordResponse(req, res, time.Since(start))
 
-	for _, f := range c.AfterFuncs {
-		if err := f(ctx, req, res); err != nil {
-			return nil, err
-		}
+	if err != nil {
+		return nil, err
 	}
 
 	if res.StatusCode >= 400 {
@@ -131,6 +194,38 @@ func (c IRacing) do(ctx context.Context, req *http.Request) (*http.Response, err
 }
 
 func (c *IRacing) json(ctx context.Context, method, path string, body, into interface{}) error {
+	ttl, cacheable := c.cacheTTL(method, path)
+	mode := cacheModeFromContext(ctx)
+
+	if cacheable && mode == cacheDefault {
+		if content, ok := c.cache.Get(path); ok {
+			return c.decode(ctx, path, content, into)
+		}
+	}
+
+	if method == http.MethodGet {
+		return c.coalesce(ctx, path, into, func(content []byte) {
+			if !cacheable || mode == cacheBypass {
+				return
+			}
+
+			if d, ok := ttl(content); ok {
+				c.cache.Set(path, content, d)
+			}
+		})
+	}
+
+	content, err := c.fetch(ctx, method, path, body)
+
+	if err != nil {
+		return err
+	}
+
+	return c.decode(ctx, path, content, into)
+}
+
+// fetch makes a request to the API and returns the raw response body
+func (c *IRacing) fetch(ctx context.Context, method, path string, body interface{}) ([]byte, error) {
 	var reader io.Reader
 
 	if body != nil {
@@ -140,7 +235,7 @@ func (c *IRacing) json(ctx context.Context, method, path string, body, into inte
 		default:
 			buffer := new(bytes.Buffer)
 			if err := json.NewEncoder(buffer).Encode(body); err != nil {
-				return err
+				return nil, err
 			}
 
 			reader = buffer
@@ -155,36 +250,63 @@ func (c *IRacing) json(ctx context.Context, method, path string, body, into inte
 	res, err := c.do(ctx, req)
 
 	if err != nil {
-		return err
+		if res != nil {
+			res.Body.Close()
+		}
+
+		return nil, err
 	}
 
+	defer res.Body.Close()
+
 	if res.StatusCode >= 400 {
 		if res.StatusCode >= 500 {
-			return errors.New("server returned an error")
+			return nil, errors.New("server returned an error")
 		}
 
-		return errors.New("server rejected our request")
+		return nil, errors.New("server rejected our request")
 	}
 
-	content, err := ioutil.ReadAll(res.Body)
+	return ioutil.ReadAll(res.Body)
+}
 
-	if err != nil {
-		return err
-	}
+// decode decodes the URL-escaped JSON content of a response from path
+func (c *IRacing) decode(ctx context.Context, path string, content []byte, into interface{}) error {
+	_, span := c.startSpan(ctx, "decode", "http.path", path)
+	defer span.End()
 
 	decoded, err := url.QueryUnescape(string(content))
 
+	if err == nil {
+		err = json.Unmarshal([]byte(decoded), into)
+	}
+
 	if err != nil {
-		return err
+		c.logger.Error("failed to decode response", "path", path, "error", err)
 	}
 
-	return json.Unmarshal([]byte(decoded), into)
+	return span.fail(err)
 }
 
 // Login will log into the iRacing Service
 //
-// NOTE: Middleware is not invoked for Login requests.
+// NOTE: Middleware is invoked for Login requests, whose body contains the user's credentials.
 func (c *IRacing) Login(ctx context.Context) error {
+	ctx, span := c.startSpan(ctx, "Login")
+	defer span.End()
+
+	err := c.login(ctx)
+
+	c.metrics.Login(atomic.LoadInt32(&c.loggedIn) == 1, err)
+
+	if err == nil {
+		atomic.StoreInt32(&c.loggedIn, 1)
+	}
+
+	return span.fail(err)
+}
+
+func (c *IRacing) login(ctx context.Context) error {
 
 	credentials, err := c.credentialsProvider()
 
@@ -198,7 +320,7 @@ func (c *IRacing) Login(ctx context.Context) error {
 	params.Set("utcoffset", "0")
 	params.Set("todaysdate", "")
 
-	req, _ := http.NewRequestWithContext(ctx, http.MethodPost, Host+"/membersite/Login", strings.NewReader(params.Encode()))
+	req, _ := http.NewRequestWithContext(ctx, http.MethodPost, Host+loginPath, strings.NewReader(params.Encode()))
 
 	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
 
@@ -218,6 +340,7 @@ func (c *IRacing) Login(ctx context.Context) error {
 		}
 
 		if strings.Contains(string(content), "Invalid email address/password or failed reCaptcha. Please try again.") {
+			c.logger.Warn("login failed", "reason", "invalid credentials")
 			return ErrLoginFailed
 		}
 
@@ -226,9 +349,7 @@ func (c *IRacing) Login(ctx context.Context) error {
 		redirect := res.Header.Get("Location")
 
 		if redirect == "https://members.iracing.com/membersite/failedlogin.jsp" {
-
-			io.Copy(os.Stderr, res.Body)
-
+			c.logger.Warn("login failed", "redirect", redirect)
 			return ErrLoginFailed
 		}
 		return nil
@@ -0,0 +1,80 @@
+// Package irapiotel adapts an OpenTelemetry tracer for use as an irapi.Tracer
+//
+// The package is a separate module so irapi itself doesn't depend on OpenTelemetry:
+//
+//	go get github.com/leoadamek/irapi/irapiotel
+//
+// Then trace the client with a tracer from any OpenTelemetry provider:
+//
+//	api.SetTracer(irapiotel.New(otel.Tracer("irapi")))
+package irapiotel
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/leoadamek/irapi"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// New creates an irapi.Tracer which starts spans with an OpenTelemetry tracer
+func New(t trace.Tracer) irapi.Tracer {
+	return tracer{t}
+}
+
+type tracer struct {
+	t trace.Tracer
+}
+
+func (t tracer) Start(ctx context.Context, name string) (context.Context, irapi.Span) {
+	ctx, s := t.t.Start(ctx, name, trace.WithSpanKind(trace.SpanKindClient))
+	return ctx, span{s}
+}
+
+type span struct {
+	s trace.Span
+}
+
+func (s span) SetAttribute(key string, value interface{}) {
+	var kv attribute.KeyValue
+
+	switch v := value.(type) {
+	case string:
+		kv = attribute.String(key, v)
+	case bool:
+		kv = attribute.Bool(key, v)
+	case int:
+		kv = attribute.Int(key, v)
+	case int64:
+		kv = attribute.Int64(key, v)
+	case uint64:
+		kv = attribute.Int64(key, int64(v))
+	case float64:
+		kv = attribute.Float64(key, v)
+	default:
+		kv = attribute.String(key, fmt.Sprint(v))
+	}
+
+	s.s.SetAttributes(kv)
+}
+
+func (s span) RecordError(err error) {
+	s.s.RecordError(err)
+	s.s.SetStatus(codes.Error, err.Error())
+}
+
+func (s span) End() {
+	s.s.End()
+}
+
+func (s span) TraceParent() string {
+	sc := s.s.SpanContext()
+
+	if !sc.IsValid() {
+		return ""
+	}
+
+	return fmt.Sprintf("00-%s-%s-%s", sc.TraceID(), sc.SpanID(), sc.TraceFlags())
+}
@@ -0,0 +1,71 @@
+package irapiotel
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestTracer(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+	_, s := New(provider.Tracer("irapi")).Start(context.Background(), "irapi.GetSeasons")
+
+	s.SetAttribute("irapi.season_id", 3000)
+	s.SetAttribute("irapi.cust_id", uint64(123))
+	s.SetAttribute("irapi.cached", true)
+	s.SetAttribute("irapi.path", "/membersite/member/GetSeasons")
+	s.RecordError(errors.New("boom"))
+
+	if tp := s.TraceParent(); !regexp.MustCompile(`^00-[0-9a-f]{32}-[0-9a-f]{16}-01$`).MatchString(tp) {
+		t.Logf("Expected a W3C traceparent but got %q", tp)
+		t.Fail()
+	}
+
+	s.End()
+
+	ended := recorder.Ended()
+
+	if len(ended) != 1 {
+		t.Fatalf("Expected 1 ended span but got %d", len(ended))
+	}
+
+	got := ended[0]
+
+	if got.Name() != "irapi.GetSeasons" {
+		t.Logf("Expected span name irapi.GetSeasons but got %s", got.Name())
+		t.Fail()
+	}
+
+	expected := []attribute.KeyValue{
+		attribute.Int("irapi.season_id", 3000),
+		attribute.Int64("irapi.cust_id", 123),
+		attribute.Bool("irapi.cached", true),
+		attribute.String("irapi.path", "/membersite/member/GetSeasons"),
+	}
+
+	attrs := make(map[attribute.Key]attribute.Value)
+
+	for _, kv := range got.Attributes() {
+		attrs[kv.Key] = kv.Value
+	}
+
+	for _, kv := range expected {
+		if v, ok := attrs[kv.Key]; !ok || v != kv.Value {
+			t.Logf("Expected attribute %s=%v but got %v", kv.Key, kv.Value.Emit(), v.Emit())
+			t.Fail()
+		}
+	}
+
+	if got.Status().Code != codes.Error || got.Status().Description != "boom" {
+		t.Logf("Expected an error status but got %+v", got.Status())
+		t.Fail()
+	}
+}
@@ -0,0 +1,75 @@
+package irapi
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrIteratorDone is returned by an iterator's Next method once every item has been returned
+var ErrIteratorDone = errors.New("no more items in iterator")
+
+// DefaultPageSize is the number of rows fetched per request by list iterators
+const DefaultPageSize = 50
+
+// pageFunc fetches the rows between lower and upper (1-based and inclusive) of a list endpoint.
+// It returns the number of rows fetched, and the total number of rows if known (otherwise zero).
+type pageFunc func(ctx context.Context, lower, upper int) (rows, total int, err error)
+
+// pager fetches the pages of a list endpoint on demand for an iterator
+//
+// Iterators embed a pager, buffering the rows its pageFunc decodes:
+//
+//	for {
+//		item, err := it.Next()
+//
+//		if err == irapi.ErrIteratorDone {
+//			break
+//		}
+//	}
+type pager struct {
+	ctx      context.Context
+	fetch    pageFunc
+	pageSize int
+	next     int
+	done     bool
+}
+
+func newPager(ctx context.Context, pageSize int, fetch pageFunc) pager {
+	if pageSize <= 0 {
+		pageSize = DefaultPageSize
+	}
+
+	return pager{
+		ctx:      ctx,
+		fetch:    fetch,
+		pageSize: pageSize,
+		next:     1,
+	}
+}
+
+// nextPage fetches the next page, returning ErrIteratorDone if there are no more rows
+//
+// If fetching fails the same page is fetched again next time.
+func (p *pager) nextPage() error {
+	if p.done {
+		return ErrIteratorDone
+	}
+
+	rows, total, err := p.fetch(p.ctx, p.next, p.next+p.pageSize-1)
+
+	if err != nil {
+		return err
+	}
+
+	p.next += p.pageSize
+
+	if rows < p.pageSize || (total > 0 && p.next > total) {
+		p.done = true
+	}
+
+	if rows == 0 {
+		return ErrIteratorDone
+	}
+
+	return nil
+}
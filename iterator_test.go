@@ -0,0 +1,61 @@
+package irapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestSeasonStandingsIteratorPages(t *testing.T) {
+	const total = 5
+
+	var pages int
+
+	api := New(StaticCredentialsProvider("", ""))
+	api.SetHTTP(&http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		pages++
+
+		lower, _ := strconv.Atoi(req.URL.Query().Get("start"))
+		upper, _ := strconv.Atoi(req.URL.Query().Get("end"))
+
+		var rows []string
+
+		for i := lower; i <= upper && i <= total; i++ {
+			rows = append(rows, fmt.Sprintf(`{"1":%d}`, i))
+		}
+
+		return jsonResponse(req, fmt.Sprintf(`{"d":{"rowcount":%d,"r":[%s]}}`, total, strings.Join(rows, ","))), nil
+	})})
+
+	it := api.GetSeasonStandings(context.Background(), 1, 2, &SeasonStandingsOptions{PageSize: 2})
+
+	rows, err := it.All()
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(rows) != total {
+		t.Fatalf("Expected %d rows but got %d", total, len(rows))
+	}
+
+	for i, r := range rows {
+		if r.Position != i+1 {
+			t.Logf("Expected position %d but got %d", i+1, r.Position)
+			t.Fail()
+		}
+	}
+
+	if pages != 3 {
+		t.Logf("Expected 3 pages to be fetched but got %d", pages)
+		t.Fail()
+	}
+
+	if _, err := it.Next(); err != ErrIteratorDone {
+		t.Logf("Expected ErrIteratorDone but got %v", err)
+		t.Fail()
+	}
+}
@@ -0,0 +1,166 @@
+package irapi
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// ErrIncompleteLeagueResults is returned alongside league sessions when the results of some of them couldn't be fetched
+var ErrIncompleteLeagueResults = errors.New("some league session results could not be fetched")
+
+// League represents a hosted league, along with its roster
+type League struct {
+	ID          uint64    `json:"leagueid"`
+	Name        string    `json:"leaguename"`
+	Description string    `json:"description"`
+	URL         string    `json:"url"`
+	OwnerID     uint64    `json:"ownerid"`
+	CreatedAt   Timestamp `json:"created"`
+
+	Roster []LeagueMember `json:"roster"`
+}
+
+// LeagueMember is a single member of a league's roster
+type LeagueMember struct {
+	UserID      uint64    `json:"custid"`
+	DisplayName string    `json:"displayname"`
+	CarNumber   string    `json:"carnumber"`
+	Owner       bool      `json:"owner"`
+	Admin       bool      `json:"admin"`
+	JoinedAt    Timestamp `json:"joined"`
+}
+
+// Member gets a member of the league's roster
+func (l League) Member(userID uint64) (*LeagueMember, bool) {
+	for i := range l.Roster {
+		if l.Roster[i].UserID == userID {
+			return &l.Roster[i], true
+		}
+	}
+
+	return nil, false
+}
+
+// Admins gets the members of the league's roster who are admins, including the owner
+func (l League) Admins() []LeagueMember {
+	var admins []LeagueMember
+
+	for _, m := range l.Roster {
+		if m.Owner || m.Admin {
+			admins = append(admins, m)
+		}
+	}
+
+	return admins
+}
+
+// LeagueSeason is a single season of a league
+type LeagueSeason struct {
+	ID       uint64 `json:"league_season_id"`
+	LeagueID uint64 `json:"leagueid"`
+	Name     string `json:"league_season_name"`
+	Active   bool   `json:"active"`
+}
+
+// LeagueSession is a single session of a league season
+//
+// Result is only set for sessions which have finished.
+// ResultErr is set instead if the results of a finished session couldn't be fetched.
+type LeagueSession struct {
+	SessionID    uint64    `json:"sessionid"`
+	SubsessionID uint64    `json:"subsessionid"`
+	SeasonID     uint64    `json:"league_season_id"`
+	Name         string    `json:"sessionname"`
+	LaunchTime   Timestamp `json:"launchat"`
+	TrackID      uint64    `json:"trackid"`
+	TrackName    string    `json:"trackname"`
+
+	Result    *SessionResult `json:"-"`
+	ResultErr error          `json:"-"`
+}
+
+// GetLeague gets a league, along with its roster
+func (c *IRacing) GetLeague(ctx context.Context, leagueID uint64) (*League, error) {
+	ctx, span := c.startSpan(ctx, "GetLeague", "irapi.league_id", leagueID)
+	defer span.End()
+
+	path := "/membersite/member/GetLeague?leagueid=" + strconv.FormatUint(leagueID, 10)
+
+	league := &League{}
+
+	if err := c.json(ctx, http.MethodGet, path, nil, league); err != nil {
+		return nil, span.fail(err)
+	}
+
+	return league, nil
+}
+
+// GetLeagueSeasons gets every season of a league
+func (c *IRacing) GetLeagueSeasons(ctx context.Context, leagueID uint64) ([]LeagueSeason, error) {
+	ctx, span := c.startSpan(ctx, "GetLeagueSeasons", "irapi.league_id", leagueID)
+	defer span.End()
+
+	path := "/membersite/member/GetLeagueSeasons?leagueid=" + strconv.FormatUint(leagueID, 10)
+
+	seasons := []LeagueSeason{}
+
+	if err := c.json(ctx, http.MethodGet, path, nil, &seasons); err != nil {
+		return nil, span.fail(err)
+	}
+
+	return seasons, nil
+}
+
+// GetLeagueSessions gets every session of a league season, with the results of those which have finished
+//
+// Results are fetched concurrently with GetSubSessionResults, so they are cached and rate limited in the same way.
+// If any results fail, every session is still returned along with ErrIncompleteLeagueResults,
+// and the ResultErr of each failed session is set.
+func (c *IRacing) GetLeagueSessions(ctx context.Context, leagueID, seasonID uint64) ([]LeagueSession, error) {
+	ctx, span := c.startSpan(ctx, "GetLeagueSessions", "irapi.league_id", leagueID, "irapi.league_season_id", seasonID)
+	defer span.End()
+
+	params := make(url.Values)
+	params.Set("leagueid", strconv.FormatUint(leagueID, 10))
+	params.Set("seasonid", strconv.FormatUint(seasonID, 10))
+
+	path := "/membersite/member/GetLeagueSessions?" + params.Encode()
+
+	decoded := []LeagueSession{}
+
+	if err := c.json(ctx, http.MethodGet, path, nil, &decoded); err != nil {
+		return nil, span.fail(err)
+	}
+
+	// The decoded sessions are copied before results are attached, so they are never changed in place
+	sessions := append([]LeagueSession(nil), decoded...)
+
+	bySubsession := make(map[uint64]int)
+	var ids []uint64
+
+	for i, s := range sessions {
+		if s.SubsessionID > 0 {
+			bySubsession[s.SubsessionID] = i
+			ids = append(ids, s.SubsessionID)
+		}
+	}
+
+	var err error
+
+	for r := range c.GetSubSessionResults(ctx, ids, nil) {
+		session := &sessions[bySubsession[r.SubSessionID]]
+
+		if r.Err != nil {
+			session.ResultErr = r.Err
+			err = ErrIncompleteLeagueResults
+			continue
+		}
+
+		session.Result = r.Result
+	}
+
+	return sessions, span.fail(err)
+}
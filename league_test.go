@@ -0,0 +1,106 @@
+package irapi
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestLeagueRoster(t *testing.T) {
+	league := League{
+		Roster: []LeagueMember{
+			{UserID: 1, Owner: true},
+			{UserID: 2, Admin: true, CarNumber: "22"},
+			{UserID: 3, CarNumber: "3"},
+		},
+	}
+
+	if m, ok := league.Member(2); !ok || m.CarNumber != "22" {
+		t.Logf("Expected member 2 with car number 22 but got %+v", m)
+		t.Fail()
+	}
+
+	if _, ok := league.Member(4); ok {
+		t.Log("Expected member 4 not to be in the roster")
+		t.Fail()
+	}
+
+	if admins := league.Admins(); len(admins) != 2 || admins[0].UserID != 1 || admins[1].UserID != 2 {
+		t.Logf("Expected the owner and admin but got %+v", admins)
+		t.Fail()
+	}
+}
+
+func TestGetLeagueSessions(t *testing.T) {
+	api := New(StaticCredentialsProvider("", ""))
+	api.SetHTTP(&http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		switch req.URL.Path {
+		case "/membersite/member/GetLeagueSessions":
+			return jsonResponse(req, `[
+				{"sessionid":1,"subsessionid":10},
+				{"sessionid":2,"subsessionid":20},
+				{"sessionid":3,"subsessionid":0}
+			]`), nil
+		case "/membersite/member/GetSubsessionResults":
+			if req.URL.Query().Get("subsessionID") == "20" {
+				res := jsonResponse(req, ``)
+				res.StatusCode = http.StatusInternalServerError
+				return res, nil
+			}
+
+			return jsonResponse(req, `{"subsessionid":10}`), nil
+		}
+
+		t.Errorf("Unexpected request to %s", req.URL.Path)
+		return jsonResponse(req, `{}`), nil
+	})})
+
+	sessions, err := api.GetLeagueSessions(context.Background(), 100, 200)
+
+	if err != ErrIncompleteLeagueResults {
+		t.Logf("Expected ErrIncompleteLeagueResults but got %v", err)
+		t.Fail()
+	}
+
+	if len(sessions) != 3 {
+		t.Fatalf("Expected 3 sessions but got %d", len(sessions))
+	}
+
+	if s := sessions[0]; s.Result == nil || s.Result.ID != 10 || s.ResultErr != nil {
+		t.Logf("Expected session 1 to be linked to subsession 10 but got %+v", s)
+		t.Fail()
+	}
+
+	if s := sessions[1]; s.Result != nil || s.ResultErr == nil {
+		t.Logf("Expected session 2 to have a result error but got %+v", s)
+		t.Fail()
+	}
+
+	if s := sessions[2]; s.Result != nil || s.ResultErr != nil {
+		t.Logf("Expected session 3 to have no result but got %+v", s)
+		t.Fail()
+	}
+
+	if results := LeagueResults(sessions); len(results) != 1 || results[0].ID != 10 {
+		t.Logf("Expected only the result of subsession 10 to be scored but got %+v", results)
+		t.Fail()
+	}
+}
+
+func TestGetLeagueSessionsConcurrently(t *testing.T) {
+	api, release := heldClient(func(req *http.Request) string {
+		if req.URL.Path == "/membersite/member/GetSubsessionResults" {
+			return `{"subsessionid":10}`
+		}
+
+		return `[{"sessionid":1,"subsessionid":10}]`
+	})
+
+	callCoalesced(api, release, 2, func() {
+		sessions, err := api.GetLeagueSessions(context.Background(), 100, 200)
+
+		if err != nil || len(sessions) != 1 || sessions[0].Result == nil || sessions[0].Result.ID != 10 {
+			t.Errorf("Expected session 1 to be linked to subsession 10 but got %+v (%v)", sessions, err)
+		}
+	})
+}
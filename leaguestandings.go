@@ -0,0 +1,371 @@
+package irapi
+
+import (
+	"sort"
+)
+
+// DefaultPointsSession is the simulation session scored when a PointsSystem doesn't name one
+const DefaultPointsSession = "RACE"
+
+// PointsSystem represents how a league scores its races
+//
+// Positions, poles, fastest laps and laps led are all within a car class when ByClass is set,
+// otherwise within the whole field.
+type PointsSystem struct {
+	// Points is the points awarded for each finishing position, winner first.
+	// Finishing outside of these positions scores nothing.
+	Points []int
+
+	// Pole is the bonus for starting first
+	Pole int
+
+	// FastestLap is the bonus for setting the fastest lap of the race
+	FastestLap int
+
+	// MostLapsLed is the bonus for leading the most laps of the race
+	MostLapsLed int
+
+	// IncidentLimit is the number of incidents a driver may have in a race before being penalised
+	IncidentLimit int
+
+	// IncidentPenalty is the points deducted for every IncidentInterval incidents over the limit
+	IncidentPenalty int
+
+	// IncidentInterval is the number of incidents over the limit for each penalty, defaulting to one
+	IncidentInterval int
+
+	// DropWeeks is the number of each driver's worst rounds which don't count, including rounds they missed
+	DropWeeks int
+
+	// ByClass scores each car class separately, producing a standings table per class
+	ByClass bool
+
+	// Session is the name of the simulation session scored, defaulting to DefaultPointsSession
+	Session string
+}
+
+// LeagueStandings is a standings table for a league season, or for one car class of it
+type LeagueStandings struct {
+	// CarClassID and CarClassName are only set when scoring by class
+	CarClassID   uint
+	CarClassName string
+
+	Rows []LeagueStanding
+}
+
+// LeagueStanding is a single driver's row of a standings table
+type LeagueStanding struct {
+	Position  int
+	UserID    int
+	Name      string
+	CarNumber string
+
+	Points    int
+	Dropped   int
+	Wins      int
+	Podiums   int
+	Incidents int
+
+	// Rounds holds the driver's score in each round, in the order the results were given
+	Rounds []LeagueRound
+}
+
+// LeagueRound is a driver's score in a single round of a league season
+type LeagueRound struct {
+	SubsessionID uint64
+
+	// Position is the driver's finishing position, starting from one, or zero if they didn't take part
+	Position int
+
+	Points  int
+	Penalty int
+	Dropped bool
+}
+
+// Total gets the points scored in the round, after penalties
+func (r LeagueRound) Total() int {
+	return r.Points - r.Penalty
+}
+
+// LeagueResults gets the results of every finished session of a league season, in order, for scoring
+func LeagueResults(sessions []LeagueSession) []SessionResult {
+	var results []SessionResult
+
+	for _, s := range sessions {
+		if s.Result != nil {
+			results = append(results, *s.Result)
+		}
+	}
+
+	return results
+}
+
+// Standings scores the results of a league season, ranking drivers in each table
+//
+// It only uses the results given, so it can be rerun offline over results from a cache.
+// Drivers level on points are ranked by count-back of their finishing positions,
+// then by fewest incidents.
+func (p PointsSystem) Standings(results []SessionResult) []LeagueStandings {
+	session := p.Session
+
+	if session == "" {
+		session = DefaultPointsSession
+	}
+
+	tables := make(map[uint]*LeagueStandings)
+	drivers := make(map[uint]map[int]*LeagueStanding)
+	var classes []uint
+
+	for round, result := range results {
+		fields := make(map[uint][]CarResult)
+
+		for _, r := range result.Results {
+			if r.SessionName != session {
+				continue
+			}
+
+			var class uint
+
+			if p.ByClass {
+				class = r.CarClassID
+			}
+
+			if _, ok := tables[class]; !ok {
+				tables[class] = &LeagueStandings{}
+				drivers[class] = make(map[int]*LeagueStanding)
+				classes = append(classes, class)
+
+				if p.ByClass {
+					tables[class].CarClassID = r.CarClassID
+					tables[class].CarClassName = r.CarClassName
+				}
+			}
+
+			fields[class] = append(fields[class], r)
+		}
+
+		for class, field := range fields {
+			for _, score := range p.score(field) {
+				d, ok := drivers[class][score.result.UserID]
+
+				if !ok {
+					d = &LeagueStanding{
+						UserID: score.result.UserID,
+						Rounds: make([]LeagueRound, len(results)),
+					}
+
+					for i := range d.Rounds {
+						d.Rounds[i].SubsessionID = results[i].ID
+					}
+
+					drivers[class][score.result.UserID] = d
+				}
+
+				// Later rounds overwrite the name and number, so the table shows the most recent
+				d.Name = score.result.Name
+				d.CarNumber = score.result.CarNumber
+				d.Incidents += score.result.Incidents
+
+				switch {
+				case score.position == 1:
+					d.Wins++
+					d.Podiums++
+				case score.position <= 3:
+					d.Podiums++
+				}
+
+				d.Rounds[round] = LeagueRound{
+					SubsessionID: result.ID,
+					Position:     score.position,
+					Points:       score.points,
+					Penalty:      score.penalty,
+				}
+			}
+		}
+	}
+
+	sort.Slice(classes, func(i, j int) bool {
+		return classes[i] < classes[j]
+	})
+
+	standings := make([]LeagueStandings, 0, len(classes))
+
+	for _, class := range classes {
+		table := tables[class]
+
+		for _, d := range drivers[class] {
+			p.total(d)
+			table.Rows = append(table.Rows, *d)
+		}
+
+		rankStandings(table.Rows)
+		standings = append(standings, *table)
+	}
+
+	return standings
+}
+
+type roundScore struct {
+	result   CarResult
+	position int
+	points   int
+	penalty  int
+}
+
+// score scores a single field of a race, which is either a car class or every car
+func (p PointsSystem) score(field []CarResult) []roundScore {
+	sort.SliceStable(field, func(i, j int) bool {
+		return field[i].FinishPosition < field[j].FinishPosition
+	})
+
+	pole, fastest, mostLed := -1, -1, -1
+
+	for i, r := range field {
+		if pole < 0 || r.StartPosition < field[pole].StartPosition {
+			pole = i
+		}
+
+		if r.BestLapTime > 0 && (fastest < 0 || r.BestLapTime < field[fastest].BestLapTime) {
+			fastest = i
+		}
+
+		if r.LapsLed > 0 && (mostLed < 0 || r.LapsLed > field[mostLed].LapsLed) {
+			mostLed = i
+		}
+	}
+
+	interval := p.IncidentInterval
+
+	if interval <= 0 {
+		interval = 1
+	}
+
+	scores := make([]roundScore, len(field))
+
+	for i, r := range field {
+		s := roundScore{result: r, position: i + 1}
+
+		if i < len(p.Points) {
+			s.points = p.Points[i]
+		}
+
+		if i == pole {
+			s.points += p.Pole
+		}
+
+		if i == fastest {
+			s.points += p.FastestLap
+		}
+
+		if i == mostLed {
+			s.points += p.MostLapsLed
+		}
+
+		if over := r.Incidents - p.IncidentLimit; p.IncidentPenalty != 0 && over > 0 {
+			s.penalty = over / interval * p.IncidentPenalty
+		}
+
+		scores[i] = s
+	}
+
+	return scores
+}
+
+// total drops a driver's worst rounds and adds up the rest
+func (p PointsSystem) total(d *LeagueStanding) {
+	drop := p.DropWeeks
+
+	if drop < 0 {
+		drop = 0
+	} else if drop > len(d.Rounds) {
+		drop = len(d.Rounds)
+	}
+
+	order := make([]int, len(d.Rounds))
+
+	for i := range order {
+		order[i] = i
+	}
+
+	// Worst first, dropping earlier rounds when scores are equal
+	sort.SliceStable(order, func(i, j int) bool {
+		return d.Rounds[order[i]].Total() < d.Rounds[order[j]].Total()
+	})
+
+	for _, i := range order[:drop] {
+		d.Rounds[i].Dropped = true
+	}
+
+	for _, r := range d.Rounds {
+		if r.Dropped {
+			d.Dropped += r.Total()
+		} else {
+			d.Points += r.Total()
+		}
+	}
+}
+
+// rankStandings orders a standings table and sets the position of each row
+func rankStandings(rows []LeagueStanding) {
+	sort.Slice(rows, func(i, j int) bool {
+		a, b := rows[i], rows[j]
+
+		if a.Points != b.Points {
+			return a.Points > b.Points
+		}
+
+		if c := countback(a, b); c != 0 {
+			return c > 0
+		}
+
+		if a.Incidents != b.Incidents {
+			return a.Incidents < b.Incidents
+		}
+
+		return a.UserID < b.UserID
+	})
+
+	for i := range rows {
+		rows[i].Position = i + 1
+	}
+}
+
+// countback compares how many times two drivers finished in each position, best first
+//
+// It returns a positive number if a is ahead, negative if b is ahead, and zero if they are level.
+func countback(a, b LeagueStanding) int {
+	finishes := func(d LeagueStanding) map[int]int {
+		m := make(map[int]int)
+
+		for _, r := range d.Rounds {
+			if r.Position > 0 {
+				m[r.Position]++
+			}
+		}
+
+		return m
+	}
+
+	fa, fb := finishes(a), finishes(b)
+	last := 0
+
+	for pos := range fa {
+		if pos > last {
+			last = pos
+		}
+	}
+
+	for pos := range fb {
+		if pos > last {
+			last = pos
+		}
+	}
+
+	for pos := 1; pos <= last; pos++ {
+		if fa[pos] != fb[pos] {
+			return fa[pos] - fb[pos]
+		}
+	}
+
+	return 0
+}
@@ -0,0 +1,136 @@
+package irapi
+
+import (
+	"testing"
+)
+
+func leagueRace(id uint64, rows ...CarResult) SessionResult {
+	for i := range rows {
+		rows[i].SessionName = DefaultPointsSession
+	}
+
+	// Qualifying rows must be ignored
+	rows = append(rows, CarResult{UserID: 99, SessionName: "QUALIFY"})
+
+	return SessionResult{ID: id, Results: rows}
+}
+
+func TestPointsSystemStandings(t *testing.T) {
+	results := []SessionResult{
+		leagueRace(1,
+			CarResult{UserID: 1, Name: "One", FinishPosition: 0, StartPosition: 1, BestLapTime: 900, LapsLed: 10},
+			CarResult{UserID: 2, Name: "Two", FinishPosition: 1, StartPosition: 0, BestLapTime: 800, Incidents: 9},
+			CarResult{UserID: 3, Name: "Three", FinishPosition: 2, StartPosition: 2, BestLapTime: 0},
+		),
+		leagueRace(2,
+			CarResult{UserID: 2, Name: "Two", FinishPosition: 0, StartPosition: 0},
+			CarResult{UserID: 3, Name: "Three", FinishPosition: 1, StartPosition: 1},
+		),
+	}
+
+	points := PointsSystem{
+		Points:           []int{10, 6, 4},
+		Pole:             1,
+		FastestLap:       1,
+		MostLapsLed:      2,
+		IncidentLimit:    4,
+		IncidentPenalty:  1,
+		IncidentInterval: 2,
+	}
+
+	standings := points.Standings(results)
+
+	if len(standings) != 1 {
+		t.Fatalf("Expected 1 standings table but got %d", len(standings))
+	}
+
+	expected := []struct {
+		userID int
+		points int
+	}{
+		// 6 + pole + fastest lap - 2 for 5 incidents over the limit, then 10 + pole
+		{2, 17},
+		{1, 12},
+		{3, 10},
+	}
+
+	rows := standings[0].Rows
+
+	if len(rows) != len(expected) {
+		t.Fatalf("Expected %d rows but got %d", len(expected), len(rows))
+	}
+
+	for i, e := range expected {
+		if rows[i].UserID != e.userID || rows[i].Points != e.points || rows[i].Position != i+1 {
+			t.Logf("Expected user %d with %d points at position %d but got %+v", e.userID, e.points, i+1, rows[i])
+			t.Fail()
+		}
+	}
+
+	// Drop weeks include rounds which weren't raced
+	points.DropWeeks = 1
+	rows = points.Standings(results)[0].Rows
+
+	if r := rows[0]; r.UserID != 1 || r.Points != 12 || !r.Rounds[1].Dropped || r.Rounds[1].SubsessionID != 2 {
+		t.Logf("Expected user 1 to lead with 12 points after dropping round 2 but got %+v", r)
+		t.Fail()
+	}
+
+	if r := rows[1]; r.UserID != 2 || r.Points != 11 || r.Dropped != 6 {
+		t.Logf("Expected user 2 second with 11 points and 6 dropped but got %+v", r)
+		t.Fail()
+	}
+}
+
+func TestPointsSystemStandingsTieBreak(t *testing.T) {
+	results := []SessionResult{
+		leagueRace(1,
+			CarResult{UserID: 1, FinishPosition: 0},
+			CarResult{UserID: 2, FinishPosition: 1},
+		),
+		leagueRace(2,
+			CarResult{UserID: 2, FinishPosition: 0, Incidents: 4},
+			CarResult{UserID: 1, FinishPosition: 1},
+		),
+		leagueRace(3,
+			CarResult{UserID: 3, FinishPosition: 0},
+			CarResult{UserID: 2, FinishPosition: 1},
+		),
+	}
+
+	// Users 1 and 2 are level on points and wins, but user 2 has more second places
+	points := PointsSystem{Points: []int{3, 1}}
+	rows := points.Standings(results)[0].Rows
+
+	if rows[0].UserID != 2 || rows[1].UserID != 1 || rows[2].UserID != 3 {
+		t.Logf("Expected users in order 2, 1, 3 but got %d, %d, %d", rows[0].UserID, rows[1].UserID, rows[2].UserID)
+		t.Fail()
+	}
+}
+
+func TestPointsSystemStandingsByClass(t *testing.T) {
+	results := []SessionResult{
+		leagueRace(1,
+			CarResult{UserID: 1, CarClassID: 20, CarClassName: "GT3", FinishPosition: 0},
+			CarResult{UserID: 2, CarClassID: 10, CarClassName: "LMP2", FinishPosition: 1},
+			CarResult{UserID: 3, CarClassID: 20, CarClassName: "GT3", FinishPosition: 2},
+		),
+	}
+
+	points := PointsSystem{Points: []int{10, 6}, ByClass: true}
+	standings := points.Standings(results)
+
+	if len(standings) != 2 {
+		t.Fatalf("Expected 2 standings tables but got %d", len(standings))
+	}
+
+	if s := standings[0]; s.CarClassID != 10 || s.CarClassName != "LMP2" || len(s.Rows) != 1 || s.Rows[0].Points != 10 {
+		t.Logf("Expected LMP2 table with the class winner on 10 points but got %+v", s)
+		t.Fail()
+	}
+
+	if s := standings[1]; s.CarClassID != 20 || len(s.Rows) != 2 || s.Rows[1].UserID != 3 || s.Rows[1].Points != 6 {
+		t.Logf("Expected GT3 table with user 3 second on 6 points but got %+v", s)
+		t.Fail()
+	}
+}
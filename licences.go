@@ -1,5 +1,7 @@
 package irapi
 
+import "fmt"
+
 type LicenceClass int
 
 const (
@@ -11,7 +13,7 @@ const (
 	LicenceClassPro
 	LicenceClassProWC
 
-	licenseClassStrings = " RDCBDA"
+	licenseClassStrings = " RDCBA"
 )
 
 func (l LicenceClass) String() string {
@@ -23,22 +25,26 @@ func (l LicenceClass) String() string {
 		return "Pro"
 	}
 
+	if l < 0 || l > LicenceClassProWC {
+		return fmt.Sprintf("Invalid LicenceClass: %d", int(l))
+	}
+
 	return string(licenseClassStrings[l])
 }
 
 type LicenceCategory int
 
 const (
-	LicenceCategoryRoad LicenceCategory = iota + 1
-	LicenceCategoryOval
-	LicenceCategoryDirtRoad
+	LicenceCategoryOval LicenceCategory = iota + 1
+	LicenceCategoryRoad
 	LicenceCategoryDirtOval
+	LicenceCategoryDirtRoad
 )
 
 func (l LicenceCategory) String() string {
 	s := ""
 
-	if l >= LicenceCategoryDirtRoad {
+	if l >= LicenceCategoryDirtOval {
 		s = "Dirt "
 	}
 
@@ -50,3 +56,96 @@ func (l LicenceCategory) String() string {
 
 	return s
 }
+
+// Licence represents a member's licence and ratings in a single category
+type Licence struct {
+	Class         LicenceClass    `json:"licGroup"`
+	Level         LicenceLevel    `json:"licLevel"`
+	SRSub         int             `json:"srSub,string"`
+	SRPrime       int             `json:"srPrime,string"`
+	TTRating      Rating          `json:"ttRating"`
+	MPRRaces      int             `json:"mprNumRaces"`
+	MPRTimeTrials int             `json:"mprNumTTs"`
+	IRating       Rating          `json:"iRating"`
+	Category      LicenceCategory `json:"catId"`
+	DisplayName   string          `json:"licLevelDisplayName"`
+	Color         string          `json:"licColor"`
+	GroupName     string          `json:"licGroupDisplayName"`
+}
+
+// SafetyRating gets the licence class and safety rating of the licence
+func (l Licence) SafetyRating() SafetyRating {
+	return NewSafetyRating(l.Class, l.SRPrime, l.SRSub)
+}
+
+// Licences is a member's set of licences, one per category
+type Licences []Licence
+
+// Category gets the licence for a category
+func (l Licences) Category(category LicenceCategory) (Licence, bool) {
+	for _, licence := range l {
+		if licence.Category == category {
+			return licence, true
+		}
+	}
+
+	return Licence{}, false
+}
+
+// SafetyRating is a licence class along with the safety rating held in it
+//
+// The rating is fixed-point in hundredths, so an SR of 3.45 has a Rating of 345.
+type SafetyRating struct {
+	Class  LicenceClass
+	Rating int
+}
+
+// NewSafetyRating creates a SafetyRating from the whole and hundredths parts of the rating
+func NewSafetyRating(class LicenceClass, prime, sub int) SafetyRating {
+	return SafetyRating{Class: class, Rating: prime*100 + sub}
+}
+
+// Float gets the safety rating as a floating point number, without the class
+func (s SafetyRating) Float() float64 {
+	return float64(s.Rating) / 100
+}
+
+// Less reports whether s is a lower licence than o, comparing classes first and then ratings
+func (s SafetyRating) Less(o SafetyRating) bool {
+	if s.Class != o.Class {
+		return s.Class < o.Class
+	}
+
+	return s.Rating < o.Rating
+}
+
+func (s SafetyRating) String() string {
+	return fmt.Sprintf("%s %d.%02d", s.Class, s.Rating/100, s.Rating%100)
+}
+
+// LicenceLevel is iRacing's combined licence level
+//
+// Each class has four levels, so 1-4 are Rookie, 5-8 are D and so on up to 17-20 for A, with Pro licences above.
+type LicenceLevel int
+
+const licenceLevelsPerClass = 4
+
+// Class gets the licence class of the level
+func (l LicenceLevel) Class() LicenceClass {
+	if l < 1 {
+		return 0
+	}
+
+	class := LicenceClass((int(l)-1)/licenceLevelsPerClass + 1)
+
+	if class > LicenceClassProWC {
+		return LicenceClassProWC
+	}
+
+	return class
+}
+
+// SafetyRating combines the level with a safety rating in hundredths, known to iRacing as the "sub level"
+func (l LicenceLevel) SafetyRating(subLevel int) SafetyRating {
+	return SafetyRating{Class: l.Class(), Rating: subLevel}
+}
@@ -0,0 +1,147 @@
+package irapi
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestSafetyRatingString(t *testing.T) {
+	mapping := map[string]SafetyRating{
+		"A 3.45":      NewSafetyRating(LicenceClassA, 3, 45),
+		"R 2.05":      NewSafetyRating(LicenceClassRookie, 2, 5),
+		"Pro/WC 4.99": {Class: LicenceClassProWC, Rating: 499},
+	}
+
+	for expected, input := range mapping {
+		if actual := input.String(); actual != expected {
+			t.Logf("Expected '%s' but got '%s'", expected, actual)
+			t.Fail()
+		}
+	}
+}
+
+func TestSafetyRatingLess(t *testing.T) {
+	ordered := []SafetyRating{
+		NewSafetyRating(LicenceClassD, 4, 99),
+		NewSafetyRating(LicenceClassC, 1, 0),
+		NewSafetyRating(LicenceClassC, 3, 45),
+		NewSafetyRating(LicenceClassA, 2, 50),
+	}
+
+	for i := 1; i < len(ordered); i++ {
+		if !ordered[i-1].Less(ordered[i]) || ordered[i].Less(ordered[i-1]) {
+			t.Logf("Expected %s to be less than %s", ordered[i-1], ordered[i])
+			t.Fail()
+		}
+	}
+}
+
+func TestLicenceLevelClass(t *testing.T) {
+	mapping := map[LicenceLevel]LicenceClass{
+		1:  LicenceClassRookie,
+		4:  LicenceClassRookie,
+		5:  LicenceClassD,
+		12: LicenceClassC,
+		13: LicenceClassB,
+		20: LicenceClassA,
+		21: LicenceClassPro,
+		30: LicenceClassProWC,
+	}
+
+	for input, expected := range mapping {
+		if actual := input.Class(); actual != expected {
+			t.Logf("Expected level %d to be %s but got %s", input, expected, actual)
+			t.Fail()
+		}
+	}
+}
+
+func TestLicenceSafetyRating(t *testing.T) {
+	var l Licence
+
+	if err := json.Unmarshal([]byte(`{"licGroup":4,"licLevel":15,"srPrime":"3","srSub":"45","catId":2}`), &l); err != nil {
+		t.Fatal(err)
+	}
+
+	if sr := l.SafetyRating(); sr.String() != "B 3.45" {
+		t.Logf("Expected 'B 3.45' but got '%s'", sr)
+		t.Fail()
+	}
+
+	if l.Category != LicenceCategoryRoad {
+		t.Logf("Expected category Road but got %s", l.Category)
+		t.Fail()
+	}
+}
+
+func TestLicenceClassStringOutOfRange(t *testing.T) {
+	if s := LicenceClass(15).String(); s != "Invalid LicenceClass: 15" {
+		t.Logf("Expected an invalid class but got '%s'", s)
+		t.Fail()
+	}
+}
+
+func TestChartPointSafetyRating(t *testing.T) {
+	var point ChartPoint
+
+	if err := json.Unmarshal([]byte(`[1600000000000, 4349]`), &point); err != nil {
+		t.Fatal(err)
+	}
+
+	if sr := point.SafetyRating(); sr != NewSafetyRating(LicenceClassB, 3, 49) {
+		t.Logf("Expected B 3.49 but got %s", sr)
+		t.Fail()
+	}
+}
+
+func TestSearchResultLicenceLevels(t *testing.T) {
+	var row SearchResultData
+
+	if err := json.Unmarshal([]byte(`{"24":15,"25":20}`), &row); err != nil {
+		t.Fatal(err)
+	}
+
+	if row.HelmetLicenceLevel.Class() != LicenceClassB || row.WinnerLicenseLevel.Class() != LicenceClassA {
+		t.Logf("Expected B and A licences but got %s and %s", row.HelmetLicenceLevel.Class(), row.WinnerLicenseLevel.Class())
+		t.Fail()
+	}
+}
+
+func TestLicenceCategoryValues(t *testing.T) {
+	// These must match iRacing's category IDs, as they are sent in requests and decoded from responses
+	mapping := map[LicenceCategory]struct {
+		id   int
+		name string
+	}{
+		LicenceCategoryOval:     {1, "Oval"},
+		LicenceCategoryRoad:     {2, "Road"},
+		LicenceCategoryDirtOval: {3, "Dirt Oval"},
+		LicenceCategoryDirtRoad: {4, "Dirt Road"},
+	}
+
+	for category, expected := range mapping {
+		if int(category) != expected.id || category.String() != expected.name {
+			t.Logf("Expected %s to be %d but got %s (%d)", expected.name, expected.id, category, int(category))
+			t.Fail()
+		}
+	}
+}
+
+func TestLicenceClassString(t *testing.T) {
+	mapping := map[LicenceClass]string{
+		LicenceClassRookie: "R",
+		LicenceClassD:      "D",
+		LicenceClassC:      "C",
+		LicenceClassB:      "B",
+		LicenceClassA:      "A",
+		LicenceClassPro:    "Pro",
+		LicenceClassProWC:  "Pro/WC",
+	}
+
+	for class, expected := range mapping {
+		if actual := class.String(); actual != expected {
+			t.Logf("Expected '%s' but got '%s'", expected, actual)
+			t.Fail()
+		}
+	}
+}
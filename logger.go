@@ -0,0 +1,135 @@
+package irapi
+
+import (
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// Logger is a structured logger, taking a message followed by alternating keys and values
+//
+// This is the same form of logging as `log/slog`, so a *slog.Logger can be used directly.
+type Logger interface {
+	Debug(msg string, args ...interface{})
+	Info(msg string, args ...interface{})
+	Warn(msg string, args ...interface{})
+	Error(msg string, args ...interface{})
+}
+
+// SetLogger sets the logger used by the client
+//
+// Requests are logged at Debug, throttling and failed logins at Warn, and failed requests and decoding at Error.
+// Cookies, passwords and the Login form are redacted before they are logged.
+func (c *IRacing) SetLogger(l Logger) {
+	if l == nil {
+		l = nopLogger{}
+	}
+
+	c.logger = l
+}
+
+type nopLogger struct{}
+
+func (nopLogger) Debug(string, ...interface{}) {}
+func (nopLogger) Info(string, ...interface{})  {}
+func (nopLogger) Warn(string, ...interface{})  {}
+func (nopLogger) Error(string, ...interface{}) {}
+
+// NewStdLogger creates a Logger which writes to a standard library logger
+//
+// Each entry is written on one line, as the level and message followed by `key=value` pairs.
+func NewStdLogger(l *log.Logger) Logger {
+	return stdLogger{l}
+}
+
+type stdLogger struct {
+	l *log.Logger
+}
+
+func (s stdLogger) Debug(msg string, args ...interface{}) { s.log("DEBUG", msg, args) }
+func (s stdLogger) Info(msg string, args ...interface{})  { s.log("INFO", msg, args) }
+func (s stdLogger) Warn(msg string, args ...interface{})  { s.log("WARN", msg, args) }
+func (s stdLogger) Error(msg string, args ...interface{}) { s.log("ERROR", msg, args) }
+
+func (s stdLogger) log(level, msg string, args []interface{}) {
+	b := new(strings.Builder)
+
+	b.WriteString(level)
+	b.WriteByte(' ')
+	b.WriteString(msg)
+
+	for i := 0; i < len(args); i += 2 {
+		if i+1 == len(args) {
+			fmt.Fprintf(b, " !BADKEY=%v", args[i])
+			break
+		}
+
+		fmt.Fprintf(b, " %v=%v", args[i], args[i+1])
+	}
+
+	s.l.Println(b.String())
+}
+
+const redacted = "REDACTED"
+
+var (
+	sensitiveHeaders = []string{"Cookie", "Set-Cookie", "Authorization"}
+	sensitiveFields  = []string{"username", "password"}
+)
+
+// redactHeaders gets a copy of the headers with any credentials redacted
+func redactHeaders(h http.Header) http.Header {
+	h = h.Clone()
+
+	for _, k := range sensitiveHeaders {
+		if _, ok := h[k]; ok {
+			h.Set(k, redacted)
+		}
+	}
+
+	return h
+}
+
+// redactBody gets the body of a request for logging, with any credentials redacted
+//
+// The Login form is always redacted entirely.
+func redactBody(req *http.Request) string {
+	if req.GetBody == nil {
+		return ""
+	}
+
+	if req.URL.Path == loginPath {
+		return redacted
+	}
+
+	body, err := req.GetBody()
+
+	if err != nil {
+		return ""
+	}
+
+	defer body.Close()
+
+	content, err := ioutil.ReadAll(body)
+
+	if err != nil {
+		return ""
+	}
+
+	form, err := url.ParseQuery(string(content))
+
+	if err != nil {
+		return string(content)
+	}
+
+	for _, k := range sensitiveFields {
+		if _, ok := form[k]; ok {
+			form.Set(k, redacted)
+		}
+	}
+
+	return form.Encode()
+}
@@ -0,0 +1,123 @@
+package irapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// recordingLogger keeps every entry logged, as the message followed by `key=value` pairs
+type recordingLogger struct {
+	mu      sync.Mutex
+	entries []string
+}
+
+func (r *recordingLogger) Debug(msg string, args ...interface{}) { r.log(msg, args) }
+func (r *recordingLogger) Info(msg string, args ...interface{})  { r.log(msg, args) }
+func (r *recordingLogger) Warn(msg string, args ...interface{})  { r.log(msg, args) }
+func (r *recordingLogger) Error(msg string, args ...interface{}) { r.log(msg, args) }
+
+func (r *recordingLogger) log(msg string, args []interface{}) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	b := new(strings.Builder)
+	b.WriteString(msg)
+
+	for i := 0; i+1 < len(args); i += 2 {
+		fmt.Fprintf(b, " %v=%v", args[i], args[i+1])
+	}
+
+	r.entries = append(r.entries, b.String())
+}
+
+func TestLoginIsRedacted(t *testing.T) {
+	logger := &recordingLogger{}
+
+	api := testClient(func(req *http.Request) string { return `` })
+	api.SetLogger(logger)
+	api.Use(func(next Handler) Handler {
+		return func(ctx context.Context, req *http.Request) (*http.Response, error) {
+			req.Header.Set("Cookie", "session=secret-cookie")
+			req.Header.Set("Authorization", "Basic secret-auth")
+			return next(ctx, req)
+		}
+	})
+
+	api.credentialsProvider = StaticCredentialsProvider("driver@example.com", "hunter2")
+
+	if err := api.Login(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(logger.entries) == 0 {
+		t.Fatal("Expected the login request to be logged")
+	}
+
+	for _, entry := range logger.entries {
+		for _, secret := range []string{"driver@example.com", "hunter2", "secret-cookie", "secret-auth"} {
+			if strings.Contains(entry, secret) {
+				t.Logf("Expected '%s' to be redacted from log entry: %s", secret, entry)
+				t.Fail()
+			}
+		}
+	}
+
+	if !strings.Contains(logger.entries[len(logger.entries)-1], "body="+redacted) {
+		t.Logf("Expected the Login body to be logged as %s: %s", redacted, logger.entries[len(logger.entries)-1])
+		t.Fail()
+	}
+}
+
+func TestRedactBodyFields(t *testing.T) {
+	form := url.Values{"username": {"driver@example.com"}, "password": {"hunter2"}, "custid": {"123"}}
+
+	req, _ := http.NewRequest(http.MethodPost, Host+"/membersite/member/Other", strings.NewReader(form.Encode()))
+
+	body, err := url.ParseQuery(redactBody(req))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if body.Get("username") != redacted || body.Get("password") != redacted {
+		t.Logf("Expected the credentials to be redacted but got %v", body)
+		t.Fail()
+	}
+
+	if body.Get("custid") != "123" {
+		t.Logf("Expected other fields to be kept but got %v", body)
+		t.Fail()
+	}
+}
+
+func TestRedactHeaders(t *testing.T) {
+	h := make(http.Header)
+	h.Set("Cookie", "session=secret")
+	h.Set("Set-Cookie", "session=secret")
+	h.Set("Authorization", "Bearer secret")
+	h.Set("Accept", "application/json")
+
+	redactedHeaders := redactHeaders(h)
+
+	for _, k := range []string{"Cookie", "Set-Cookie", "Authorization"} {
+		if v := redactedHeaders.Get(k); v != redacted {
+			t.Logf("Expected %s to be redacted but got '%s'", k, v)
+			t.Fail()
+		}
+
+		if h.Get(k) == redacted {
+			t.Logf("Expected the original %s header to be left alone", k)
+			t.Fail()
+		}
+	}
+
+	if v := redactedHeaders.Get("Accept"); v != "application/json" {
+		t.Logf("Expected Accept to be kept but got '%s'", v)
+		t.Fail()
+	}
+}
@@ -2,8 +2,12 @@ package irapi
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"net/http"
+	"net/url"
 	"strconv"
+	"strings"
 )
 
 // CareerStats represents a member's lifetmie career stats for a single category
@@ -27,6 +31,9 @@ type CareerStats struct {
 
 // GetCareerStats gets the lifetime career stats for a user
 func (c *IRacing) GetCareerStats(ctx context.Context, userID uint64) ([]CareerStats, error) {
+	ctx, span := c.startSpan(ctx, "GetCareerStats", "irapi.cust_id", userID)
+	defer span.End()
+
 	path := "/memberstats/member/GetCareerStats?custid=" + strconv.FormatUint(userID, 10)
 
 	careerStats := []CareerStats{}
@@ -34,8 +41,199 @@ func (c *IRacing) GetCareerStats(ctx context.Context, userID uint64) ([]CareerSt
 	err := c.json(ctx, http.MethodGet, path, nil, &careerStats)
 
 	if err != nil {
-		return nil, err
+		return nil, span.fail(err)
 	}
 
 	return careerStats, nil
 }
+
+// YearlyStats represents a member's stats for a single year in a single category
+type YearlyStats struct {
+	CareerStats
+	Year int `json:"year"`
+}
+
+// GetYearlyStats gets a user's stats for each year and category they have raced in
+func (c *IRacing) GetYearlyStats(ctx context.Context, userID uint64) ([]YearlyStats, error) {
+	ctx, span := c.startSpan(ctx, "GetYearlyStats", "irapi.cust_id", userID)
+	defer span.End()
+
+	path := "/memberstats/member/GetYearlyStats?custid=" + strconv.FormatUint(userID, 10)
+
+	yearlyStats := []YearlyStats{}
+
+	if err := c.json(ctx, http.MethodGet, path, nil, &yearlyStats); err != nil {
+		return nil, span.fail(err)
+	}
+
+	return yearlyStats, nil
+}
+
+// SeasonStats represents a member's stats for a single season of a series
+type SeasonStats struct {
+	CareerStats
+	SeasonID   int    `json:"seasonid"`
+	SeriesID   int    `json:"seriesid"`
+	SeriesName string `json:"seriesname"`
+	Year       int    `json:"year"`
+	Quarter    int    `json:"quarter"`
+}
+
+// GetSeasonStats gets a user's stats for each season they have raced in
+func (c *IRacing) GetSeasonStats(ctx context.Context, userID uint64) ([]SeasonStats, error) {
+	ctx, span := c.startSpan(ctx, "GetSeasonStats", "irapi.cust_id", userID)
+	defer span.End()
+
+	path := "/memberstats/member/GetSeasonStats?custid=" + strconv.FormatUint(userID, 10)
+
+	seasonStats := []SeasonStats{}
+
+	if err := c.json(ctx, http.MethodGet, path, nil, &seasonStats); err != nil {
+		return nil, span.fail(err)
+	}
+
+	return seasonStats, nil
+}
+
+var (
+	// ErrMemberNotFound is returned when no member matches a name
+	ErrMemberNotFound = errors.New("no member found")
+)
+
+// AmbiguousMemberError is returned when a name matches more than one member
+type AmbiguousMemberError struct {
+	Name       string
+	Candidates []MemberSearchResult
+}
+
+func (e *AmbiguousMemberError) Error() string {
+	return fmt.Sprintf("%d members match '%s'", len(e.Candidates), e.Name)
+}
+
+// MemberSearchResult is a single member matching a search by display name
+type MemberSearchResult struct {
+	ClubName     string          `json:"1"`
+	IRating      Rating          `json:"2"`
+	LicenceGroup LicenceClass    `json:"3"`
+	DisplayName  string          `json:"4"`
+	ClubID       int             `json:"5"`
+	UserID       uint64          `json:"6"`
+	LicenceLevel LicenceLevel    `json:"7"`
+	Category     LicenceCategory `json:"8"`
+	TTRating     Rating          `json:"9"`
+	RowNumber    int             `json:"10"`
+	SubLevel     int             `json:"11"`
+}
+
+// SafetyRating gets the member's licence and safety rating in the searched category
+func (r MemberSearchResult) SafetyRating() SafetyRating {
+	return r.LicenceLevel.SafetyRating(r.SubLevel)
+}
+
+type searchMembersResponse struct {
+	Headers map[string]string `json:"m"`
+	Data    struct {
+		Count int                  `json:"rowcount"`
+		Rows  []MemberSearchResult `json:"r"`
+	} `json:"d"`
+}
+
+// SearchMembers searches for active members whose display name contains the query
+//
+// At most 50 members are returned, highest iRating first.
+func (c *IRacing) SearchMembers(ctx context.Context, query string) ([]MemberSearchResult, error) {
+	ctx, span := c.startSpan(ctx, "SearchMembers")
+	defer span.End()
+
+	rows, _, err := c.searchMembers(ctx, query, 1, DefaultPageSize)
+
+	if err != nil {
+		return nil, span.fail(err)
+	}
+
+	return rows, nil
+}
+
+// searchMembers gets the rows between lower and upper of a member search, along with the total number of matches
+func (c *IRacing) searchMembers(ctx context.Context, query string, lower, upper int) ([]MemberSearchResult, int, error) {
+	params := make(url.Values)
+	params.Set("search", query)
+	params.Set("friend", "-1")
+	params.Set("watched", "-1")
+	params.Set("recent", "-1")
+	params.Set("active", "1")
+	params.Set("lowerbound", strconv.Itoa(lower))
+	params.Set("upperbound", strconv.Itoa(upper))
+	params.Set("sort", "irating")
+	params.Set("order", "desc")
+
+	path := "/memberstats/member/GetDriverStats?" + params.Encode()
+
+	resp := &searchMembersResponse{}
+
+	if err := c.json(ctx, http.MethodGet, path, nil, resp); err != nil {
+		return nil, 0, err
+	}
+
+	return resp.Data.Rows, resp.Data.Count, nil
+}
+
+// ResolveMemberPages is the most pages of search results ResolveMember checks for an exact match
+const ResolveMemberPages = 4
+
+// ResolveMember finds the single member with the given display name
+//
+// A member whose name matches exactly (ignoring case) is preferred over partial matches,
+// so up to ResolveMemberPages pages of the search are checked, which takes one request per 50 partial matches.
+// If no member matches ErrMemberNotFound is returned,
+// and if several members match equally well, or there are too many matches to check, an *AmbiguousMemberError listing them is returned.
+// Only the 50 partial matches with the highest iRating are listed.
+func (c *IRacing) ResolveMember(ctx context.Context, name string) (*MemberSearchResult, error) {
+	ctx, span := c.startSpan(ctx, "ResolveMember")
+	defer span.End()
+
+	name = strings.TrimSpace(name)
+
+	var exact, partial []MemberSearchResult
+
+	p := newPager(ctx, DefaultPageSize, func(ctx context.Context, lower, upper int) (int, int, error) {
+		rows, total, err := c.searchMembers(ctx, name, lower, upper)
+
+		if err != nil {
+			return 0, 0, err
+		}
+
+		for _, r := range rows {
+			if strings.EqualFold(strings.TrimSpace(r.DisplayName), name) {
+				exact = append(exact, r)
+			} else if len(partial) < DefaultPageSize {
+				partial = append(partial, r)
+			}
+		}
+
+		return len(rows), total, nil
+	})
+
+	for pages := 0; pages < ResolveMemberPages; pages++ {
+		if err := p.nextPage(); err == ErrIteratorDone {
+			break
+		} else if err != nil {
+			return nil, span.fail(err)
+		}
+	}
+
+	candidates := exact
+
+	if len(exact) == 0 {
+		candidates = partial
+	}
+
+	switch {
+	case len(candidates) == 0:
+		return nil, span.fail(ErrMemberNotFound)
+	case len(candidates) == 1:
+		return &candidates[0], nil
+	default:
+		return nil, span.fail(&AmbiguousMemberError{Name: name, Candidates: candidates})
+	}
+}
@@ -0,0 +1,185 @@
+package irapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// memberSearchClient creates a client whose member search matches the given display names, in order
+func memberSearchClient(names ...string) (*IRacing, *int) {
+	requests := 0
+
+	api := testClient(func(req *http.Request) string {
+		requests++
+
+		q := req.URL.Query()
+		lower, _ := strconv.Atoi(q.Get("lowerbound"))
+		upper, _ := strconv.Atoi(q.Get("upperbound"))
+
+		var rows []string
+
+		for i := lower; i <= upper && i <= len(names); i++ {
+			rows = append(rows, fmt.Sprintf(`{"4":%q,"6":%d,"10":%d}`, names[i-1], i, i))
+		}
+
+		return fmt.Sprintf(`{"m":{},"d":{"rowcount":%d,"r":[%s]}}`, len(names), strings.Join(rows, ","))
+	})
+
+	return api, &requests
+}
+
+func TestResolveMemberExactMatchOnLaterPage(t *testing.T) {
+	names := make([]string, 120)
+
+	for i := range names {
+		names[i] = fmt.Sprintf("John Smith%d", i)
+	}
+
+	names[100] = "john smith"
+
+	api, requests := memberSearchClient(names...)
+
+	member, err := api.ResolveMember(context.Background(), " John Smith ")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if member.UserID != 101 {
+		t.Logf("Expected member 101 but got %+v", member)
+		t.Fail()
+	}
+
+	if *requests != 3 {
+		t.Logf("Expected 3 pages to be searched but got %d", *requests)
+		t.Fail()
+	}
+}
+
+func TestResolveMemberPageLimit(t *testing.T) {
+	names := make([]string, (ResolveMemberPages+1)*DefaultPageSize)
+
+	for i := range names {
+		names[i] = fmt.Sprintf("John Smith%d", i)
+	}
+
+	names[len(names)-1] = "John Smith"
+
+	api, requests := memberSearchClient(names...)
+
+	_, err := api.ResolveMember(context.Background(), "John Smith")
+
+	if e, ok := err.(*AmbiguousMemberError); !ok || len(e.Candidates) != DefaultPageSize {
+		t.Logf("Expected an AmbiguousMemberError with %d candidates but got %v", DefaultPageSize, err)
+		t.Fail()
+	}
+
+	if *requests != ResolveMemberPages {
+		t.Logf("Expected %d pages to be searched but got %d", ResolveMemberPages, *requests)
+		t.Fail()
+	}
+}
+
+func TestResolveMemberAmbiguousExactMatches(t *testing.T) {
+	api, _ := memberSearchClient("John Smith", "John Smithson", "JOHN SMITH")
+
+	_, err := api.ResolveMember(context.Background(), "John Smith")
+
+	ambiguous, ok := err.(*AmbiguousMemberError)
+
+	if !ok {
+		t.Fatalf("Expected an AmbiguousMemberError but got %v", err)
+	}
+
+	if len(ambiguous.Candidates) != 2 || ambiguous.Candidates[0].UserID != 1 || ambiguous.Candidates[1].UserID != 3 {
+		t.Logf("Expected only the exact matches as candidates but got %+v", ambiguous.Candidates)
+		t.Fail()
+	}
+}
+
+func TestResolveMemberPartialMatches(t *testing.T) {
+	api, _ := memberSearchClient("John Smithson")
+
+	if member, err := api.ResolveMember(context.Background(), "John Smith"); err != nil || member.UserID != 1 {
+		t.Logf("Expected the only partial match but got %+v (%v)", member, err)
+		t.Fail()
+	}
+
+	api, _ = memberSearchClient("John Smithson", "John Smithers")
+
+	if _, err := api.ResolveMember(context.Background(), "John Smith"); err == nil {
+		t.Log("Expected several partial matches to be ambiguous")
+		t.Fail()
+	} else if ambiguous, ok := err.(*AmbiguousMemberError); !ok || len(ambiguous.Candidates) != 2 {
+		t.Logf("Expected an AmbiguousMemberError with 2 candidates but got %v", err)
+		t.Fail()
+	}
+}
+
+func TestResolveMemberNotFound(t *testing.T) {
+	api, _ := memberSearchClient()
+
+	if _, err := api.ResolveMember(context.Background(), "Nobody"); err != ErrMemberNotFound {
+		t.Logf("Expected ErrMemberNotFound but got %v", err)
+		t.Fail()
+	}
+}
+
+func TestGetYearlyStats(t *testing.T) {
+	api := testClient(func(req *http.Request) string {
+		if req.URL.Path != "/memberstats/member/GetYearlyStats" || req.URL.Query().Get("custid") != "123" {
+			t.Errorf("Unexpected request to %s", req.URL)
+		}
+
+		return `[
+			{"year":2020,"category":"Road","starts":40,"wins":3,"top5":12,"lapsLed":55,"avgIncPerRace":2.5},
+			{"year":2021,"category":"Oval","starts":10,"wins":0}
+		]`
+	})
+
+	stats, err := api.GetYearlyStats(context.Background(), 123)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(stats) != 2 {
+		t.Fatalf("Expected 2 years but got %d", len(stats))
+	}
+
+	if s := stats[0]; s.Year != 2020 || s.Category != "Road" || s.Starts != 40 || s.Wins != 3 ||
+		s.TopFiveFinishes != 12 || s.LapsLed != 55 || s.AverageIncidentsPerRace != 2.5 {
+		t.Logf("Unexpected stats for 2020 %+v", s)
+		t.Fail()
+	}
+}
+
+func TestGetSeasonStats(t *testing.T) {
+	api := testClient(func(req *http.Request) string {
+		if req.URL.Path != "/memberstats/member/GetSeasonStats" || req.URL.Query().Get("custid") != "123" {
+			t.Errorf("Unexpected request to %s", req.URL)
+		}
+
+		return `[{"seasonid":3000,"seriesid":34,"seriesname":"Skip Barber","year":2020,"quarter":4,"starts":8,"poles":2}]`
+	})
+
+	stats, err := api.GetSeasonStats(context.Background(), 123)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(stats) != 1 {
+		t.Fatalf("Expected 1 season but got %d", len(stats))
+	}
+
+	if s := stats[0]; s.SeasonID != 3000 || s.SeriesID != 34 || s.SeriesName != "Skip Barber" ||
+		s.Year != 2020 || s.Quarter != 4 || s.Starts != 8 || s.Poles != 2 {
+		t.Logf("Unexpected season stats %+v", s)
+		t.Fail()
+	}
+}
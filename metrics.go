@@ -0,0 +1,65 @@
+package irapi
+
+import (
+	"net/http"
+	"time"
+)
+
+// Metrics records measurements of the client's requests to iRacing
+//
+// Endpoints are identified by their path, excluding the query string.
+// Implementations must be safe for concurrent use. PrometheusMetrics is provided.
+type Metrics interface {
+	// Request records a response from an endpoint, with its status and latency.
+	// A status of zero means no response was received.
+	Request(endpoint string, status int, duration time.Duration)
+
+	// Retry records a throttled request to an endpoint being retried
+	Retry(endpoint string)
+
+	// Throttled records a 429 Too Many Requests response from an endpoint
+	Throttled(endpoint string)
+
+	// Maintenance records a response from an endpoint while iRacing is offline for maintenance
+	Maintenance(endpoint string)
+
+	// Login records a login, and whether it replaced an earlier session
+	Login(relogin bool, err error)
+}
+
+// SetMetrics sets where the client records metrics for its requests
+func (c *IRacing) SetMetrics(m Metrics) {
+	if m == nil {
+		m = nopMetrics{}
+	}
+
+	c.metrics = m
+}
+
+// recordResponse records the metrics for a single response, or the lack of one
+func (c *IRacing) recordResponse(req *http.Request, res *http.Response, duration time.Duration) {
+	endpoint := req.URL.Path
+
+	if res == nil {
+		c.metrics.Request(endpoint, 0, duration)
+		return
+	}
+
+	c.metrics.Request(endpoint, res.StatusCode, duration)
+
+	if res.StatusCode == http.StatusTooManyRequests {
+		c.metrics.Throttled(endpoint)
+	}
+
+	if res.Header.Get("X-Maintenance-Mode") == "true" {
+		c.metrics.Maintenance(endpoint)
+	}
+}
+
+type nopMetrics struct{}
+
+func (nopMetrics) Request(string, int, time.Duration) {}
+func (nopMetrics) Retry(string)                       {}
+func (nopMetrics) Throttled(string)                   {}
+func (nopMetrics) Maintenance(string)                 {}
+func (nopMetrics) Login(bool, error)                  {}
@@ -0,0 +1,75 @@
+package irapi
+
+import (
+	"context"
+	"net/http"
+)
+
+// Handler sends a request to iRacing and returns its response
+//
+// As with `IRacing.do`, a Handler may return a response alongside an error,
+// such as ErrTooManyRequests or ErrMaintenance.
+type Handler func(ctx context.Context, req *http.Request) (*http.Response, error)
+
+// Middleware wraps a Handler, returning a Handler which runs around it
+//
+// Middleware may modify the request, time or retry it by calling next more than once,
+// or short-circuit it by returning a response without calling next at all.
+// Middleware which calls next more than once is responsible for resetting `req.Body`.
+type Middleware func(next Handler) Handler
+
+// Use adds middleware to the chain wrapping every request, including Login
+//
+// Middleware added first runs outermost. Middleware runs after the default headers are set,
+// and wraps retries of throttled requests.
+func (c *IRacing) Use(m ...Middleware) {
+	c.middleware = append(c.middleware, m...)
+}
+
+// handler gets the Handler which runs a request through the middleware chain
+func (c *IRacing) handler() Handler {
+	h := Handler(c.roundTrip)
+
+	for i := len(c.middleware) - 1; i >= 0; i-- {
+		h = c.middleware[i](h)
+	}
+
+	return h
+}
+
+// Before adapts a BeforeFunc into Middleware
+//
+// If the BeforeFunc returns an error the request is not sent.
+func Before(f BeforeFunc) Middleware {
+	return func(next Handler) Handler {
+		return func(ctx context.Context, req *http.Request) (*http.Response, error) {
+			if err := f(ctx, req); err != nil {
+				return nil, err
+			}
+
+			return next(ctx, req)
+		}
+	}
+}
+
+// After adapts an AfterFunc into Middleware
+//
+// The AfterFunc only runs when a response was received, and if it returns an error the response is discarded.
+func After(f AfterFunc) Middleware {
+	return func(next Handler) Handler {
+		return func(ctx context.Context, req *http.Request) (*http.Response, error) {
+			res, err := next(ctx, req)
+
+			if res == nil {
+				return res, err
+			}
+
+			if ferr := f(ctx, req, res); ferr != nil {
+				res.Body.Close()
+				return nil, ferr
+			}
+
+			return res, err
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package irapi
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestMiddlewareShortCircuit(t *testing.T) {
+	api := New(StaticCredentialsProvider("", ""))
+	api.SetHTTP(&http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		t.Log("Expected the request to be short-circuited")
+		t.Fail()
+		return nil, errors.New("unexpected request")
+	})})
+
+	var order []string
+
+	api.BeforeRequest(func(context.Context, *http.Request) error {
+		order = append(order, "before")
+		return nil
+	})
+
+	api.Use(func(next Handler) Handler {
+		return func(ctx context.Context, req *http.Request) (*http.Response, error) {
+			order = append(order, "cached")
+			return jsonResponse(req, `{"subsessionid":1234}`), nil
+		}
+	})
+
+	result, err := api.GetSubSessionResult(context.Background(), 1234)
+
+	if err != nil || result.ID != 1234 {
+		t.Logf("Expected the cached result but got %+v (%v)", result, err)
+		t.Fail()
+	}
+
+	if len(order) != 2 || order[0] != "before" || order[1] != "cached" {
+		t.Logf("Expected middleware to run in order but got %v", order)
+		t.Fail()
+	}
+}
+
+func TestAfterSkippedWithoutResponse(t *testing.T) {
+	transportErr := errors.New("connection refused")
+
+	api := New(StaticCredentialsProvider("", ""))
+	api.SetHTTP(&http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		return nil, transportErr
+	})})
+
+	api.AfterResponse(func(context.Context, *http.Request, *http.Response) error {
+		t.Log("Expected AfterFunc not to run without a response")
+		t.Fail()
+		return nil
+	})
+
+	if _, err := api.GetSubSessionResult(context.Background(), 1234); !errors.Is(err, transportErr) {
+		t.Logf("Expected the transport error but got %v", err)
+		t.Fail()
+	}
+}
@@ -12,10 +12,15 @@ import (
 // StringifiedUint64 is a uint64 represented as a human-readable string
 type StringifiedUint64 uint64
 
-func (u *StringifiedUint64) UnmarhsalJSON(b []byte) error {
+// UnmarshalJSON decodes a number given either as a JSON number or as a string with thousands separators
+func (u *StringifiedUint64) UnmarshalJSON(b []byte) error {
 	// Get the string
 	str := string(b)
 
+	if unquoted, err := strconv.Unquote(str); err == nil {
+		str = unquoted
+	}
+
 	// Remove the commas
 	str = strings.ReplaceAll(str, ",", "")
 
@@ -31,16 +36,41 @@ func (u *StringifiedUint64) UnmarhsalJSON(b []byte) error {
 	return nil
 }
 
+// MarshalJSON encodes the number as a JSON number
 func (u StringifiedUint64) MarshalJSON() ([]byte, error) {
 	return []byte(strconv.FormatUint(uint64(u), 10)), nil
 }
 
+// NumericBool is a bool which iRacing gives as either a JSON boolean or the number 0 or 1
+type NumericBool bool
+
+// UnmarshalJSON decodes a bool given either as a JSON boolean or as a number, where any non-zero number is true
+func (n *NumericBool) UnmarshalJSON(b []byte) error {
+	switch str := string(b); str {
+	case "true":
+		*n = true
+	case "false", "null":
+		*n = false
+	default:
+		val, err := strconv.ParseFloat(str, 64)
+
+		if err != nil {
+			return fmt.Errorf("invalid bool: %s", str)
+		}
+
+		*n = val != 0
+	}
+
+	return nil
+}
+
 // Laptime represents a laptime as a Duration, but with a textual representation of a wall-clock
 type Laptime time.Duration
 
-var laptimeRegexp = regexp.MustCompile(`^((?P<minutes>\d+):)?(?P<seconds>\d|[1-5]\d)\.(?P<millis>\d{3})$`)
+var laptimeRegexp = regexp.MustCompile(`^((?P<minutes>\d+):)?(?P<seconds>[0-5]?\d)\.(?P<millis>\d{3})$`)
 
-func (l *Laptime) UnmarhsalJSON(b []byte) error {
+// UnmarshalJSON decodes a Laptime from its wall-clock form, e.g. "1:43.580"
+func (l *Laptime) UnmarshalJSON(b []byte) error {
 
 	str := string(b)
 
@@ -50,8 +80,18 @@ func (l *Laptime) UnmarhsalJSON(b []byte) error {
 		return err
 	}
 
+	// iRacing gives an empty time when there is no lap
+	if str == "" {
+		*l = 0
+		return nil
+	}
+
 	parts := laptimeRegexp.FindStringSubmatch(str)
 
+	if parts == nil {
+		return fmt.Errorf("invalid laptime '%s'", str)
+	}
+
 	var minutes uint64
 
 	if parts[2] == "" {
@@ -90,7 +130,7 @@ func (l Laptime) String() string {
 	millis := (d.Milliseconds()) - (1000 * seconds) - (60 * 1000 * minutes)
 
 	if minutes > 0 {
-		return fmt.Sprintf("%d:%d.%03d", minutes, seconds, millis)
+		return fmt.Sprintf("%d:%02d.%03d", minutes, seconds, millis)
 	}
 
 	return fmt.Sprintf("%d.%03d", seconds, millis)
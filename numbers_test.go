@@ -1,6 +1,7 @@
 package irapi
 
 import (
+	"encoding/json"
 	"testing"
 	"time"
 )
@@ -13,22 +14,72 @@ func TestLapTimeUnmarshalJSON(t *testing.T) {
 		`"15.000"`,
 		`"1.234"`,
 		`"5:55.555"`,
+		`"1:05.123"`,
 	}
 
 	for _, v := range validTimes {
 		var l Laptime
 
-		if err := l.UnmarhsalJSON([]byte(v)); err != nil {
+		if err := l.UnmarshalJSON([]byte(v)); err != nil {
 			t.Log("Unexpected Parse Error:", err)
 			t.Fail()
 		}
 	}
 }
 
+func TestStringifiedUint64UnmarshalJSON(t *testing.T) {
+	mapping := map[string]StringifiedUint64{
+		`"1,234,567"`: 1234567,
+		`"42"`:        42,
+		`1234`:        1234,
+	}
+
+	for input, expected := range mapping {
+		var u StringifiedUint64
+
+		if err := json.Unmarshal([]byte(input), &u); err != nil {
+			t.Log("Unexpected Parse Error:", err)
+			t.Fail()
+		}
+
+		if u != expected {
+			t.Logf("Expected %d but got %d", expected, u)
+			t.Fail()
+		}
+	}
+}
+
+func TestNumericBoolUnmarshalJSON(t *testing.T) {
+	tests := map[string]NumericBool{
+		`1`:     true,
+		`0`:     false,
+		`true`:  true,
+		`false`: false,
+		`null`:  false,
+	}
+
+	for in, expected := range tests {
+		var b NumericBool
+
+		if err := json.Unmarshal([]byte(in), &b); err != nil || b != expected {
+			t.Logf("Expected %s to decode as %t but got %t (%v)", in, expected, b, err)
+			t.Fail()
+		}
+	}
+
+	var b NumericBool
+
+	if err := json.Unmarshal([]byte(`"yes"`), &b); err == nil {
+		t.Log("Expected an error decoding a string")
+		t.Fail()
+	}
+}
+
 func TestLaptimeString(t *testing.T) {
 	mapping := map[string]Laptime{
 		"1:42.548": Laptime(1*time.Minute + 42*time.Second + 548*time.Millisecond),
 		"1.234":    Laptime(1*time.Second + 234*time.Millisecond),
+		"1:05.123": Laptime(1*time.Minute + 5*time.Second + 123*time.Millisecond),
 	}
 
 	for expected, input := range mapping {
@@ -47,7 +98,7 @@ func BenchmarkLaptimeString(b *testing.B) {
 	input := Laptime(3*time.Minute + 28*time.Second + 544*time.Millisecond)
 
 	for i := 0; i < b.N; i++ {
-		input.String()
+		_ = input.String()
 	}
 }
 
@@ -64,7 +115,7 @@ func BenchmarkLaptimeUnmarshalJSON(b *testing.B) {
 
 	for i := 0; i < b.N; i++ {
 		for _, v := range validTimes {
-			l.UnmarhsalJSON([]byte(v))
+			l.UnmarshalJSON([]byte(v))
 		}
 	}
 }
@@ -0,0 +1,150 @@
+package irapi
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+const (
+	personalBestRace      = "Race"
+	personalBestQualify   = "Qualify"
+	personalBestTimeTrial = "Time Trial"
+)
+
+type personalBestRow struct {
+	TrackID     uint64  `json:"trackid"`
+	TrackName   string  `json:"trackname"`
+	TrackConfig string  `json:"trackconfigname"`
+	EventType   string  `json:"eventtypename"`
+	BestLapTime Laptime `json:"bestlaptimeformatted"`
+}
+
+// PersonalBest is a member's best laps in a car at a single track configuration
+//
+// A zero Laptime means the member has no lap of that kind.
+type PersonalBest struct {
+	UserID      uint64
+	CarID       uint64
+	TrackID     uint64
+	TrackName   string
+	TrackConfig string
+
+	Race       Laptime
+	Qualifying Laptime
+	TimeTrial  Laptime
+}
+
+// GetPersonalBests gets a member's best race, qualifying and time trial laps in a car at every track configuration
+func (c *IRacing) GetPersonalBests(ctx context.Context, userID, carID uint64) ([]PersonalBest, error) {
+	ctx, span := c.startSpan(ctx, "GetPersonalBests", "irapi.cust_id", userID, "irapi.car_id", carID)
+	defer span.End()
+
+	params := make(url.Values)
+	params.Set("custid", strconv.FormatUint(userID, 10))
+	params.Set("carid", strconv.FormatUint(carID, 10))
+
+	path := "/memberstats/member/GetPersonalBests?" + params.Encode()
+
+	var rows []personalBestRow
+
+	if err := c.json(ctx, http.MethodGet, path, nil, &rows); err != nil {
+		return nil, span.fail(err)
+	}
+
+	// iRacing gives a row for each kind of lap, these are grouped by track configuration
+	var bests []PersonalBest
+	index := make(map[personalBestKey]int)
+
+	for _, row := range rows {
+		key := personalBestKey{carID, row.TrackID, row.TrackConfig}
+
+		i, ok := index[key]
+
+		if !ok {
+			i = len(bests)
+			index[key] = i
+
+			bests = append(bests, PersonalBest{
+				UserID:      userID,
+				CarID:       carID,
+				TrackID:     row.TrackID,
+				TrackName:   row.TrackName,
+				TrackConfig: row.TrackConfig,
+			})
+		}
+
+		switch row.EventType {
+		case personalBestRace:
+			bests[i].Race = row.BestLapTime
+		case personalBestQualify:
+			bests[i].Qualifying = row.BestLapTime
+		case personalBestTimeTrial:
+			bests[i].TimeTrial = row.BestLapTime
+		}
+	}
+
+	return bests, nil
+}
+
+type personalBestKey struct {
+	carID       uint64
+	trackID     uint64
+	trackConfig string
+}
+
+func (p PersonalBest) key() personalBestKey {
+	return personalBestKey{p.CarID, p.TrackID, p.TrackConfig}
+}
+
+// PersonalBestDiff compares two members' personal bests in the same car at the same track configuration
+type PersonalBestDiff struct {
+	A PersonalBest
+	B PersonalBest
+}
+
+// Race gets how much slower A's best race lap is than B's, and whether both members have one
+func (d PersonalBestDiff) Race() (time.Duration, bool) {
+	return lapDelta(d.A.Race, d.B.Race)
+}
+
+// Qualifying gets how much slower A's best qualifying lap is than B's, and whether both members have one
+func (d PersonalBestDiff) Qualifying() (time.Duration, bool) {
+	return lapDelta(d.A.Qualifying, d.B.Qualifying)
+}
+
+// TimeTrial gets how much slower A's best time trial lap is than B's, and whether both members have one
+func (d PersonalBestDiff) TimeTrial() (time.Duration, bool) {
+	return lapDelta(d.A.TimeTrial, d.B.TimeTrial)
+}
+
+func lapDelta(a, b Laptime) (time.Duration, bool) {
+	if a == 0 || b == 0 {
+		return 0, false
+	}
+
+	return time.Duration(a - b), true
+}
+
+// DiffPersonalBests compares two members' personal bests on every car and track configuration they share
+//
+// Diffs are in the order of a.
+func DiffPersonalBests(a, b []PersonalBest) []PersonalBestDiff {
+	index := make(map[personalBestKey]PersonalBest, len(b))
+
+	for _, p := range b {
+		index[p.key()] = p
+	}
+
+	var diffs []PersonalBestDiff
+
+	for _, p := range a {
+		if q, ok := index[p.key()]; ok {
+			diffs = append(diffs, PersonalBestDiff{A: p, B: q})
+		}
+	}
+
+	return diffs
+}
@@ -0,0 +1,87 @@
+package irapi
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestGetPersonalBests(t *testing.T) {
+	api := testClient(func(req *http.Request) string {
+		if q := req.URL.Query(); q.Get("custid") != "1" || q.Get("carid") != "10" {
+			t.Errorf("Unexpected query %s", req.URL.RawQuery)
+		}
+
+		return `[
+			{"trackid":100,"trackname":"Lime Rock Park","trackconfigname":"Full Course","eventtypename":"Race","bestlaptimeformatted":"58.123"},
+			{"trackid":100,"trackname":"Lime Rock Park","trackconfigname":"Full Course","eventtypename":"Qualify","bestlaptimeformatted":"57.900"},
+			{"trackid":200,"trackname":"Okayama","trackconfigname":"Short","eventtypename":"Time Trial","bestlaptimeformatted":"1:02.500"},
+			{"trackid":100,"trackname":"Lime Rock Park","trackconfigname":"Full Course","eventtypename":"Time Trial","bestlaptimeformatted":"58.000"}
+		]`
+	})
+
+	bests, err := api.GetPersonalBests(context.Background(), 1, 10)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(bests) != 2 {
+		t.Fatalf("Expected bests at 2 track configurations but got %d", len(bests))
+	}
+
+	lrp := bests[0]
+
+	if lrp.TrackID != 100 || lrp.UserID != 1 || lrp.CarID != 10 ||
+		time.Duration(lrp.Race) != 58123*time.Millisecond ||
+		time.Duration(lrp.Qualifying) != 57900*time.Millisecond ||
+		time.Duration(lrp.TimeTrial) != 58*time.Second {
+		t.Logf("Unexpected bests at Lime Rock Park %+v", lrp)
+		t.Fail()
+	}
+
+	if okayama := bests[1]; okayama.TrackID != 200 || okayama.Race != 0 || time.Duration(okayama.TimeTrial) != 62500*time.Millisecond {
+		t.Logf("Unexpected bests at Okayama %+v", okayama)
+		t.Fail()
+	}
+}
+
+func TestDiffPersonalBests(t *testing.T) {
+	a := []PersonalBest{
+		{CarID: 10, TrackID: 100, TrackConfig: "Full Course", Race: Laptime(58 * time.Second), Qualifying: Laptime(57 * time.Second)},
+		{CarID: 10, TrackID: 200, Race: Laptime(62 * time.Second)},
+		{CarID: 10, TrackID: 100, TrackConfig: "Chicane", Race: Laptime(60 * time.Second)},
+	}
+
+	b := []PersonalBest{
+		{CarID: 10, TrackID: 100, TrackConfig: "Chicane", Race: Laptime(59 * time.Second)},
+		{CarID: 10, TrackID: 100, TrackConfig: "Full Course", Race: Laptime(58500 * time.Millisecond)},
+	}
+
+	diffs := DiffPersonalBests(a, b)
+
+	if len(diffs) != 2 {
+		t.Fatalf("Expected 2 shared track configurations but got %d", len(diffs))
+	}
+
+	if diffs[0].A.TrackConfig != "Full Course" || diffs[1].A.TrackConfig != "Chicane" {
+		t.Logf("Expected diffs in the order of a but got %s then %s", diffs[0].A.TrackConfig, diffs[1].A.TrackConfig)
+		t.Fail()
+	}
+
+	if d, ok := diffs[0].Race(); !ok || d != -500*time.Millisecond {
+		t.Logf("Expected A to be 0.5s faster in races but got %s (%t)", d, ok)
+		t.Fail()
+	}
+
+	if _, ok := diffs[0].Qualifying(); ok {
+		t.Log("Expected no qualifying comparison when B has no lap")
+		t.Fail()
+	}
+
+	if d, ok := diffs[1].Race(); !ok || d != time.Second {
+		t.Logf("Expected A to be 1s slower in races but got %s (%t)", d, ok)
+		t.Fail()
+	}
+}
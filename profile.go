@@ -3,39 +3,163 @@ package irapi
 import (
 	"context"
 	"net/http"
+	"strconv"
+	"time"
 )
 
 // UserProfile represents the profile for the **current** user.
 //
 // iRacing provides much more data, in a different format, for the current user.
 type UserProfile struct {
-	ID       uint64 `json:"custID"`
-	Licenses []struct {
-		Group         int    `json:"licGroup"`
-		Level         int    `json:"licLevel"`
-		SRSub         int    `json:"srSub,string"`
-		SRPrime       int    `json:"srPrime,string"`
-		TTRating      Rating `json:"ttRating"`
-		MPRRaces      int    `json:"mprNumRaces"`
-		MPRTimeTrials int    `json:"mprNumTTs"`
-		IRating       Rating `json:"iRating"`
-		CatID         int    `json:"catId"`
-		DisplayName   string `json:"licLevelDisplayName"`
-		Color         string `json:"licColor"`
-		GroupName     string `json:"licGroupDisplayName"`
-	} `json:"licenses"`
+	ID       uint64   `json:"custID"`
+	Licenses Licences `json:"licenses"`
 
 	DisplayName            string `json:"DisplayName"`
 	HasReadPrivacyPolicy   bool   `json:"hasReadPP"`
 	HasReadTermsConditions bool   `json:"hasReadTC"`
 }
 
+// MemberProfile represents the public profile of any member
+type MemberProfile struct {
+	ID          uint64 `json:"custid"`
+	DisplayName string `json:"displayName"`
+	ClubID      int    `json:"clubId"`
+	ClubName    string `json:"clubName"`
+
+	MemberSince Timestamp `json:"memberSince"`
+	LastLogin   Timestamp `json:"lastLogin"`
+	LastRace    Timestamp `json:"lastRace"`
+
+	Licenses Licences `json:"licenses"`
+
+	// RecentActivity summarises the member's last ProfileRecentRaces races
+	RecentActivity *RecentActivity `json:"-"`
+}
+
+// ProfileRecentRaces is the number of races summarised in a MemberProfile's recent activity
+const ProfileRecentRaces = 10
+
 // GetProfile gets the user's profile
 func (c *IRacing) GetProfile(ctx context.Context) (*UserProfile, error) {
+	ctx, span := c.startSpan(ctx, "GetProfile")
+	defer span.End()
 
 	profile := &UserProfile{}
 
 	err := c.json(ctx, http.MethodGet, "/membersite/member/GetMember", nil, profile)
 
-	return profile, err
+	return profile, span.fail(err)
+}
+
+// GetMemberProfile gets the public profile of any member, including their licences, ratings and recent activity
+//
+// The recent activity is fetched with GetRecentActivity, so this makes two requests.
+func (c *IRacing) GetMemberProfile(ctx context.Context, userID uint64) (*MemberProfile, error) {
+	ctx, span := c.startSpan(ctx, "GetMemberProfile", "irapi.cust_id", userID)
+	defer span.End()
+
+	path := "/membersite/member/GetMemberProfile?custid=" + strconv.FormatUint(userID, 10)
+
+	profile := &MemberProfile{}
+
+	if err := c.json(ctx, http.MethodGet, path, nil, profile); err != nil {
+		return nil, span.fail(err)
+	}
+
+	activity, err := c.GetRecentActivity(ctx, userID, ProfileRecentRaces)
+
+	if err != nil {
+		return nil, span.fail(err)
+	}
+
+	profile.RecentActivity = activity
+
+	return profile, nil
+}
+
+// OwnedContent is the cars and tracks owned by the current member, including those free with a subscription
+type OwnedContent struct {
+	CarIDs   []uint64 `json:"cars"`
+	TrackIDs []uint64 `json:"tracks"`
+}
+
+// OwnsCar reports whether the member owns a car
+func (o OwnedContent) OwnsCar(carID uint64) bool {
+	return containsUint64(o.CarIDs, carID)
+}
+
+// OwnsTrack reports whether the member owns a track configuration
+func (o OwnedContent) OwnsTrack(trackID uint64) bool {
+	return containsUint64(o.TrackIDs, trackID)
+}
+
+// OwnsCarIn reports whether the member owns any of the cars in a season's car classes
+func (o OwnedContent) OwnsCarIn(season Season) bool {
+	for _, cc := range season.CarClasses {
+		for _, car := range cc.Cars {
+			if o.OwnsCar(car.ID) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// CanRace reports whether the member owns the track for a race week of a season, and a car to race there
+func (o OwnedContent) CanRace(season Season, week int) bool {
+	track, ok := season.TrackForWeek(week)
+
+	return ok && o.OwnsTrack(track.ID) && o.OwnsCarIn(season)
+}
+
+// CanRaceSession reports whether the member owns the track and a car for a race guide session
+func (o OwnedContent) CanRaceSession(e RaceGuideEntry) bool {
+	trackID := e.TrackID
+
+	if e.Track != nil {
+		trackID = e.Track.ID
+	}
+
+	if !o.OwnsTrack(trackID) {
+		return false
+	}
+
+	for _, cc := range e.CarClasses {
+		for _, car := range cc.Cars {
+			if o.OwnsCar(car.ID) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// Raceable gets the race week of every season running at the given time which the member can race
+// without buying anything
+func (o OwnedContent) Raceable(seasons SeasonList, now time.Time) []SeasonWeek {
+	var weeks []SeasonWeek
+
+	for _, w := range seasons.CurrentWeek(now) {
+		if w.Track != nil && o.OwnsTrack(w.Track.ID) && o.OwnsCarIn(*w.Season) {
+			weeks = append(weeks, w)
+		}
+	}
+
+	return weeks
+}
+
+// GetOwnedContent gets the cars and tracks the current member owns
+func (c *IRacing) GetOwnedContent(ctx context.Context) (*OwnedContent, error) {
+	ctx, span := c.startSpan(ctx, "GetOwnedContent")
+	defer span.End()
+
+	content := &OwnedContent{}
+
+	if err := c.json(ctx, http.MethodGet, "/membersite/member/GetOwnedContent", nil, content); err != nil {
+		return nil, span.fail(err)
+	}
+
+	return content, nil
 }
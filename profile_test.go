@@ -0,0 +1,160 @@
+package irapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+)
+
+// testSeason creates a season starting at start, with a class of cars and a track for each race week
+func testSeason(t *testing.T, start time.Time) Season {
+	var season Season
+
+	err := json.Unmarshal([]byte(`{
+		"seasonid": 1,
+		"carclasses": [{"id": 5, "cars": [{"id": 10}, {"id": 11}]}],
+		"tracks": [{"id": 100, "raceweek": 0}, {"id": 200, "raceweek": 1}]
+	}`), &season)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	season.Start = Timestamp(start)
+	season.End = Timestamp(start.Add(12 * raceWeekLength))
+
+	return season
+}
+
+func TestOwnedContentCanRace(t *testing.T) {
+	season := testSeason(t, time.Date(2020, 12, 8, 0, 0, 0, 0, time.UTC))
+	owned := OwnedContent{CarIDs: []uint64{11}, TrackIDs: []uint64{100}}
+
+	if !owned.OwnsCarIn(season) {
+		t.Log("Expected a car in the season to be owned")
+		t.Fail()
+	}
+
+	if (OwnedContent{CarIDs: []uint64{12}}).OwnsCarIn(season) {
+		t.Log("Expected no car in the season to be owned")
+		t.Fail()
+	}
+
+	if !owned.CanRace(season, 0) {
+		t.Log("Expected week 0 to be raceable")
+		t.Fail()
+	}
+
+	if owned.CanRace(season, 1) {
+		t.Log("Expected week 1 not to be raceable without its track")
+		t.Fail()
+	}
+
+	if owned.CanRace(season, 5) {
+		t.Log("Expected a week without a track not to be raceable")
+		t.Fail()
+	}
+}
+
+func TestOwnedContentRaceable(t *testing.T) {
+	start := time.Date(2020, 12, 8, 0, 0, 0, 0, time.UTC)
+	owned := OwnedContent{CarIDs: []uint64{10}, TrackIDs: []uint64{200}}
+
+	seasons := SeasonList{testSeason(t, start)}
+
+	if weeks := owned.Raceable(seasons, start.Add(time.Hour)); len(weeks) != 0 {
+		t.Logf("Expected nothing raceable in week 0 but got %+v", weeks)
+		t.Fail()
+	}
+
+	weeks := owned.Raceable(seasons, start.Add(raceWeekLength+time.Hour))
+
+	if len(weeks) != 1 || weeks[0].RaceWeek != 1 || weeks[0].Track.ID != 200 {
+		t.Logf("Expected week 1 at track 200 to be raceable but got %+v", weeks)
+		t.Fail()
+	}
+}
+
+func TestOwnedContentCanRaceSession(t *testing.T) {
+	season := testSeason(t, time.Now())
+	owned := OwnedContent{CarIDs: []uint64{10}, TrackIDs: []uint64{200}}
+
+	e := RaceGuideEntry{RaceWeek: 1}
+	e.link(&season)
+
+	if !owned.CanRaceSession(e) {
+		t.Log("Expected the session to be raceable")
+		t.Fail()
+	}
+
+	e = RaceGuideEntry{RaceWeek: 0}
+	e.link(&season)
+
+	if owned.CanRaceSession(e) {
+		t.Log("Expected the session not to be raceable without its track")
+		t.Fail()
+	}
+}
+
+func TestGetMemberProfile(t *testing.T) {
+	api := testClient(func(req *http.Request) string {
+		switch req.URL.Path {
+		case "/membersite/member/GetMemberProfile":
+			if id := req.URL.Query().Get("custid"); id != "123" {
+				t.Errorf("Expected profile for member 123 but got %s", id)
+			}
+
+			return `{
+				"custid": 123,
+				"displayName": "Jane Doe",
+				"clubId": 7,
+				"clubName": "UK and I",
+				"memberSince": 1262304000000,
+				"licenses": [
+					{"catId": 2, "licGroup": 5, "licLevel": 18, "srPrime": "3", "srSub": "45", "iRating": 2500, "ttRating": "---"},
+					{"catId": 1, "licGroup": 3, "licLevel": 10, "srPrime": "2", "srSub": "10", "iRating": 1350, "ttRating": 1200}
+				]
+			}`
+		case "/memberstats/member/GetLastRacesStats":
+			return `[{"subsessionID":10,"finishPos":1},{"subsessionID":9,"finishPos":4}]`
+		}
+
+		t.Errorf("Unexpected request to %s", req.URL.Path)
+		return `{}`
+	})
+
+	profile, err := api.GetMemberProfile(context.Background(), 123)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if profile.ID != 123 || profile.DisplayName != "Jane Doe" || profile.ClubID != 7 {
+		t.Logf("Unexpected profile %+v", profile)
+		t.Fail()
+	}
+
+	if since := time.Time(profile.MemberSince).UTC(); since.Year() != 2010 {
+		t.Logf("Expected a member since 2010 but got %s", since)
+		t.Fail()
+	}
+
+	road, ok := profile.Licenses.Category(LicenceCategoryRoad)
+
+	if !ok || road.IRating != 2500 || road.SafetyRating() != NewSafetyRating(LicenceClassA, 3, 45) {
+		t.Logf("Expected an A 3.45 road licence with 2500 iRating but got %+v", road)
+		t.Fail()
+	}
+
+	if oval, ok := profile.Licenses.Category(LicenceCategoryOval); !ok || oval.Class != LicenceClassC {
+		t.Logf("Expected a C oval licence but got %+v", oval)
+		t.Fail()
+	}
+
+	if a := profile.RecentActivity; a == nil || a.Starts != 2 || a.Wins != 1 {
+		t.Logf("Expected recent activity of 2 starts and 1 win but got %+v", a)
+		t.Fail()
+	}
+}
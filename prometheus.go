@@ -0,0 +1,249 @@
+package irapi
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultLatencyBuckets are the upper bounds, in seconds, of the request latency histogram buckets
+var DefaultLatencyBuckets = []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
+// PrometheusMetrics is a Metrics which exposes its measurements in the Prometheus text format
+//
+// It is an http.Handler, so it can be served directly as a scrape target:
+//
+//	metrics := irapi.NewPrometheusMetrics()
+//	api.SetMetrics(metrics)
+//	http.Handle("/metrics", metrics)
+type PrometheusMetrics struct {
+	mu      sync.Mutex
+	buckets []float64
+
+	requests    map[requestKey]uint64
+	latencies   map[string]*histogram
+	retries     map[string]uint64
+	throttled   map[string]uint64
+	maintenance map[string]uint64
+
+	logins        uint64
+	loginFailures uint64
+	relogins      uint64
+}
+
+type requestKey struct {
+	endpoint string
+	status   int
+}
+
+type histogram struct {
+	counts []uint64
+	count  uint64
+	sum    float64
+}
+
+// NewPrometheusMetrics creates a new, empty set of metrics using DefaultLatencyBuckets
+func NewPrometheusMetrics() *PrometheusMetrics {
+	return &PrometheusMetrics{
+		buckets:     DefaultLatencyBuckets,
+		requests:    make(map[requestKey]uint64),
+		latencies:   make(map[string]*histogram),
+		retries:     make(map[string]uint64),
+		throttled:   make(map[string]uint64),
+		maintenance: make(map[string]uint64),
+	}
+}
+
+// Request records a response from an endpoint, with its status and latency
+func (p *PrometheusMetrics) Request(endpoint string, status int, duration time.Duration) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.requests[requestKey{endpoint, status}]++
+
+	h, ok := p.latencies[endpoint]
+
+	if !ok {
+		h = &histogram{counts: make([]uint64, len(p.buckets))}
+		p.latencies[endpoint] = h
+	}
+
+	seconds := duration.Seconds()
+
+	for i, le := range p.buckets {
+		if seconds <= le {
+			h.counts[i]++
+		}
+	}
+
+	h.count++
+	h.sum += seconds
+}
+
+// Retry records a throttled request to an endpoint being retried
+func (p *PrometheusMetrics) Retry(endpoint string) {
+	p.mu.Lock()
+	p.retries[endpoint]++
+	p.mu.Unlock()
+}
+
+// Throttled records a 429 Too Many Requests response from an endpoint
+func (p *PrometheusMetrics) Throttled(endpoint string) {
+	p.mu.Lock()
+	p.throttled[endpoint]++
+	p.mu.Unlock()
+}
+
+// Maintenance records a response from an endpoint while iRacing is offline for maintenance
+func (p *PrometheusMetrics) Maintenance(endpoint string) {
+	p.mu.Lock()
+	p.maintenance[endpoint]++
+	p.mu.Unlock()
+}
+
+// Login records a login, and whether it replaced an earlier session
+func (p *PrometheusMetrics) Login(relogin bool, err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if err != nil {
+		p.loginFailures++
+		return
+	}
+
+	p.logins++
+
+	if relogin {
+		p.relogins++
+	}
+}
+
+// ServeHTTP writes the metrics in the Prometheus text format
+func (p *PrometheusMetrics) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	p.WriteTo(w)
+}
+
+// WriteTo writes the metrics in the Prometheus text format
+//
+// The metrics are formatted before being written, so a slow scraper doesn't hold up requests being measured.
+func (p *PrometheusMetrics) WriteTo(w io.Writer) (int64, error) {
+	e := &exposition{}
+
+	p.mu.Lock()
+	p.format(e)
+	p.mu.Unlock()
+
+	return e.WriteTo(w)
+}
+
+// format formats the metrics in the Prometheus text format, and must be called with the lock held
+func (p *PrometheusMetrics) format(e *exposition) {
+	e.header("irapi_requests_total", "counter", "Responses received from iRacing, by endpoint and status. A status of 0 means no response was received.")
+
+	keys := make([]requestKey, 0, len(p.requests))
+
+	for k := range p.requests {
+		keys = append(keys, k)
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].endpoint == keys[j].endpoint {
+			return keys[i].status < keys[j].status
+		}
+
+		return keys[i].endpoint < keys[j].endpoint
+	})
+
+	for _, k := range keys {
+		e.sample("irapi_requests_total", labels("endpoint", k.endpoint, "status", strconv.Itoa(k.status)), float64(p.requests[k]))
+	}
+
+	e.header("irapi_request_duration_seconds", "histogram", "Latency of requests to iRacing, by endpoint.")
+
+	for _, endpoint := range sortedKeys(p.latencies) {
+		h := p.latencies[endpoint]
+
+		for i, le := range p.buckets {
+			e.sample("irapi_request_duration_seconds_bucket", labels("endpoint", endpoint, "le", formatFloat(le)), float64(h.counts[i]))
+		}
+
+		e.sample("irapi_request_duration_seconds_bucket", labels("endpoint", endpoint, "le", "+Inf"), float64(h.count))
+		e.sample("irapi_request_duration_seconds_sum", labels("endpoint", endpoint), h.sum)
+		e.sample("irapi_request_duration_seconds_count", labels("endpoint", endpoint), float64(h.count))
+	}
+
+	e.counters("irapi_retries_total", "Throttled requests which were retried, by endpoint.", p.retries)
+	e.counters("irapi_throttled_total", "429 Too Many Requests responses, by endpoint.", p.throttled)
+	e.counters("irapi_maintenance_total", "Responses received while iRacing was offline for maintenance, by endpoint.", p.maintenance)
+
+	e.header("irapi_logins_total", "counter", "Logins to iRacing, by result.")
+	e.sample("irapi_logins_total", labels("result", "success"), float64(p.logins))
+	e.sample("irapi_logins_total", labels("result", "failure"), float64(p.loginFailures))
+
+	e.header("irapi_relogins_total", "counter", "Successful logins which replaced an earlier session.")
+	e.sample("irapi_relogins_total", "", float64(p.relogins))
+}
+
+// exposition buffers metrics in the Prometheus text format
+type exposition struct {
+	bytes.Buffer
+}
+
+func (e *exposition) header(name, kind, help string) {
+	fmt.Fprintf(e, "# HELP %s %s\n# TYPE %s %s\n", name, help, name, kind)
+}
+
+func (e *exposition) sample(name, labels string, value float64) {
+	fmt.Fprintf(e, "%s%s %s\n", name, labels, formatFloat(value))
+}
+
+func (e *exposition) counters(name, help string, values map[string]uint64) {
+	e.header(name, "counter", help)
+
+	for _, endpoint := range sortedKeys(values) {
+		e.sample(name, labels("endpoint", endpoint), float64(values[endpoint]))
+	}
+}
+
+var labelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+// labels formats alternating label names and values as a Prometheus label set
+func labels(pairs ...string) string {
+	parts := make([]string, 0, len(pairs)/2)
+
+	for i := 0; i+1 < len(pairs); i += 2 {
+		parts = append(parts, pairs[i]+`="`+labelEscaper.Replace(pairs[i+1])+`"`)
+	}
+
+	return "{" + strings.Join(parts, ",") + "}"
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
+
+func sortedKeys(m interface{}) []string {
+	var keys []string
+
+	switch m := m.(type) {
+	case map[string]uint64:
+		for k := range m {
+			keys = append(keys, k)
+		}
+	case map[string]*histogram:
+		for k := range m {
+			keys = append(keys, k)
+		}
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,88 @@
+package irapi
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestPrometheusMetricsWriteTo(t *testing.T) {
+	m := NewPrometheusMetrics()
+
+	m.Request("/membersite/member/GetSeasons", 200, 300*time.Millisecond)
+	m.Request("/membersite/member/GetSeasons", 429, 50*time.Millisecond)
+	m.Throttled("/membersite/member/GetSeasons")
+	m.Retry("/membersite/member/GetSeasons")
+	m.Login(false, nil)
+	m.Login(true, nil)
+
+	buf := new(bytes.Buffer)
+
+	n, err := m.WriteTo(buf)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if n != int64(buf.Len()) {
+		t.Logf("Expected %d bytes to be written but got %d", buf.Len(), n)
+		t.Fail()
+	}
+
+	expected := []string{
+		`irapi_requests_total{endpoint="/membersite/member/GetSeasons",status="200"} 1`,
+		`irapi_requests_total{endpoint="/membersite/member/GetSeasons",status="429"} 1`,
+		`irapi_request_duration_seconds_bucket{endpoint="/membersite/member/GetSeasons",le="0.05"} 1`,
+		`irapi_request_duration_seconds_bucket{endpoint="/membersite/member/GetSeasons",le="0.25"} 1`,
+		`irapi_request_duration_seconds_bucket{endpoint="/membersite/member/GetSeasons",le="0.5"} 2`,
+		`irapi_request_duration_seconds_bucket{endpoint="/membersite/member/GetSeasons",le="+Inf"} 2`,
+		`irapi_request_duration_seconds_count{endpoint="/membersite/member/GetSeasons"} 2`,
+		`irapi_retries_total{endpoint="/membersite/member/GetSeasons"} 1`,
+		`irapi_throttled_total{endpoint="/membersite/member/GetSeasons"} 1`,
+		`irapi_logins_total{result="success"} 2`,
+		`irapi_relogins_total 1`,
+	}
+
+	for _, line := range expected {
+		if !strings.Contains(buf.String(), line+"\n") {
+			t.Logf("Expected output to contain '%s'", line)
+			t.Fail()
+		}
+	}
+}
+
+// blockingWriter blocks every write until it is released
+type blockingWriter struct {
+	writing chan struct{}
+	release chan struct{}
+}
+
+func (w blockingWriter) Write(b []byte) (int, error) {
+	close(w.writing)
+	<-w.release
+	return len(b), nil
+}
+
+func TestPrometheusMetricsSlowScrape(t *testing.T) {
+	m := NewPrometheusMetrics()
+	w := blockingWriter{writing: make(chan struct{}), release: make(chan struct{})}
+	defer close(w.release)
+
+	go m.WriteTo(w)
+	<-w.writing
+
+	measured := make(chan struct{})
+
+	go func() {
+		m.Request("/membersite/member/GetSeasons", 200, time.Millisecond)
+		close(measured)
+	}()
+
+	select {
+	case <-measured:
+	case <-time.After(time.Second):
+		t.Log("Expected requests to be measured while a scrape is being written")
+		t.Fail()
+	}
+}
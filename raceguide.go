@@ -0,0 +1,197 @@
+package irapi
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"time"
+)
+
+// RaceGuideEntry is a single upcoming official session of a series
+type RaceGuideEntry struct {
+	SessionID    uint64          `json:"sessionid"`
+	SeasonID     int             `json:"seasonid"`
+	SeriesID     int             `json:"seriesid"`
+	SeriesName   string          `json:"seriesname"`
+	Category     LicenceCategory `json:"catid"`
+	LicenceGroup LicenceClass    `json:"licgroup"`
+	RaceWeek     int             `json:"raceweek"`
+	TrackID      uint64          `json:"trackid"`
+	CarClassIDs  []uint          `json:"carclassids"`
+	StartTime    Timestamp       `json:"starttime"`
+	Registered   int             `json:"registered"`
+
+	// Season is the season the session is part of, if it is active
+	Season *Season `json:"-"`
+
+	// Track is the season's track for the session's race week
+	Track *SeasonTrack `json:"-"`
+
+	// CarClasses are the season's car classes which race in the session
+	CarClasses []CarClass `json:"-"`
+}
+
+// RaceGuideOptions represents the filters which can be given when getting the race guide
+//
+// Empty filters include every session.
+type RaceGuideOptions struct {
+	Categories     []LicenceCategory
+	LicenceClasses []LicenceClass
+	SeriesIDs      []int
+
+	// OnlyOwnedContent only includes sessions the current member can race with the content they own,
+	// see OwnedContent.CanRaceSession
+	OnlyOwnedContent bool
+}
+
+func (o *RaceGuideOptions) includes(e RaceGuideEntry) bool {
+	if len(o.Categories) > 0 && !containsCategory(o.Categories, e.Category) {
+		return false
+	}
+
+	if len(o.LicenceClasses) > 0 && !containsClass(o.LicenceClasses, e.LicenceGroup) {
+		return false
+	}
+
+	if len(o.SeriesIDs) > 0 && !containsInt(o.SeriesIDs, e.SeriesID) {
+		return false
+	}
+
+	return true
+}
+
+type raceGuideResponse struct {
+	Sessions []RaceGuideEntry `json:"sessions"`
+}
+
+// GetRaceGuide gets the upcoming official sessions of the active seasons, soonest first
+//
+// Each entry is linked to its Season, SeasonTrack and CarClasses from GetSeasons. opts may be nil.
+func (c *IRacing) GetRaceGuide(ctx context.Context, opts *RaceGuideOptions) ([]RaceGuideEntry, error) {
+	ctx, span := c.startSpan(ctx, "GetRaceGuide")
+	defer span.End()
+
+	if opts == nil {
+		opts = &RaceGuideOptions{}
+	}
+
+	resp := &raceGuideResponse{}
+
+	if err := c.json(ctx, http.MethodGet, "/membersite/member/GetRaceGuide", nil, resp); err != nil {
+		return nil, span.fail(err)
+	}
+
+	var owned *OwnedContent
+
+	if opts.OnlyOwnedContent {
+		var err error
+
+		if owned, err = c.GetOwnedContent(ctx); err != nil {
+			return nil, span.fail(err)
+		}
+	}
+
+	seasons, err := c.GetSeasons(ctx, true)
+
+	if err != nil {
+		return nil, span.fail(err)
+	}
+
+	bySeason := make(map[int]*Season, len(seasons))
+
+	for i := range seasons {
+		bySeason[seasons[i].SeasonID] = &seasons[i]
+	}
+
+	var entries []RaceGuideEntry
+
+	for _, e := range resp.Sessions {
+		if !opts.includes(e) {
+			continue
+		}
+
+		if season, ok := bySeason[e.SeasonID]; ok {
+			e.link(season)
+		}
+
+		if owned != nil && !owned.CanRaceSession(e) {
+			continue
+		}
+
+		entries = append(entries, e)
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return time.Time(entries[i].StartTime).Before(time.Time(entries[j].StartTime))
+	})
+
+	return entries, nil
+}
+
+// link links an entry to its season, and the track and car classes from it
+func (e *RaceGuideEntry) link(season *Season) {
+	e.Season = season
+
+	for i, t := range season.Tracks {
+		if t.RaceWeek == e.RaceWeek && (e.TrackID == 0 || t.ID == e.TrackID) {
+			e.Track = &season.Tracks[i]
+			break
+		}
+	}
+
+	for _, cc := range season.CarClasses {
+		if len(e.CarClassIDs) == 0 || containsUint(e.CarClassIDs, cc.ID) {
+			e.CarClasses = append(e.CarClasses, cc)
+		}
+	}
+}
+
+func containsCategory(l []LicenceCategory, v LicenceCategory) bool {
+	for _, x := range l {
+		if x == v {
+			return true
+		}
+	}
+
+	return false
+}
+
+func containsClass(l []LicenceClass, v LicenceClass) bool {
+	for _, x := range l {
+		if x == v {
+			return true
+		}
+	}
+
+	return false
+}
+
+func containsInt(l []int, v int) bool {
+	for _, x := range l {
+		if x == v {
+			return true
+		}
+	}
+
+	return false
+}
+
+func containsUint(l []uint, v uint) bool {
+	for _, x := range l {
+		if x == v {
+			return true
+		}
+	}
+
+	return false
+}
+
+func containsUint64(l []uint64, v uint64) bool {
+	for _, x := range l {
+		if x == v {
+			return true
+		}
+	}
+
+	return false
+}
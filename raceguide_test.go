@@ -0,0 +1,142 @@
+package irapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+// raceGuideClient creates a client with a race guide of two sessions in season 1, one at each of its tracks
+func raceGuideClient(t *testing.T) *IRacing {
+	return testClient(func(req *http.Request) string {
+		switch req.URL.Path {
+		case "/membersite/member/GetRaceGuide":
+			if req.URL.RawQuery != "" {
+				t.Errorf("Unexpected race guide query %s", req.URL.RawQuery)
+			}
+
+			return `{"sessions":[
+				{"sessionid":2,"seasonid":1,"seriesid":10,"catid":2,"licgroup":3,"raceweek":1,"trackid":200,"starttime":1600003600000},
+				{"sessionid":1,"seasonid":1,"seriesid":10,"catid":2,"licgroup":3,"raceweek":0,"trackid":100,"starttime":1600000000000},
+				{"sessionid":3,"seasonid":2,"seriesid":20,"catid":1,"licgroup":1,"raceweek":0,"starttime":1600001800000}
+			]}`
+		case "/membersite/member/GetSeasons":
+			return `[{"seasonid":1,"seriesid":10,
+				"carclasses":[{"id":5,"cars":[{"id":10}]},{"id":6,"cars":[{"id":11}]}],
+				"tracks":[{"id":100,"raceweek":0},{"id":200,"raceweek":1}]}]`
+		case "/membersite/member/GetOwnedContent":
+			return `{"cars":[10],"tracks":[100]}`
+		}
+
+		t.Errorf("Unexpected request to %s", req.URL.Path)
+		return `{}`
+	})
+}
+
+func TestGetRaceGuideOnlyOwnedContent(t *testing.T) {
+	entries, err := raceGuideClient(t).GetRaceGuide(context.Background(), &RaceGuideOptions{OnlyOwnedContent: true})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(entries) != 1 || entries[0].SessionID != 1 {
+		t.Logf("Expected only session 1 to be raceable but got %+v", entries)
+		t.Fail()
+	}
+}
+
+func TestGetRaceGuide(t *testing.T) {
+	entries, err := raceGuideClient(t).GetRaceGuide(context.Background(), nil)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(entries) != 3 {
+		t.Fatalf("Expected 3 sessions but got %d", len(entries))
+	}
+
+	for i, id := range []uint64{1, 3, 2} {
+		if entries[i].SessionID != id {
+			t.Logf("Expected session %d at %d but got %d", id, i, entries[i].SessionID)
+			t.Fail()
+		}
+	}
+
+	if e := entries[0]; e.Season == nil || e.Season.SeasonID != 1 || e.Track == nil || e.Track.ID != 100 || len(e.CarClasses) != 2 {
+		t.Logf("Expected session 1 to be linked to season 1 at track 100 but got %+v", e)
+		t.Fail()
+	}
+
+	if e := entries[1]; e.Season != nil || e.Track != nil || e.CarClasses != nil {
+		t.Logf("Expected session 3 of an inactive season to be unlinked but got %+v", e)
+		t.Fail()
+	}
+}
+
+func TestGetRaceGuideFilters(t *testing.T) {
+	tests := []struct {
+		opts     RaceGuideOptions
+		expected []uint64
+	}{
+		{RaceGuideOptions{Categories: []LicenceCategory{LicenceCategoryOval}}, []uint64{3}},
+		{RaceGuideOptions{LicenceClasses: []LicenceClass{LicenceClassC, LicenceClassA}}, []uint64{1, 2}},
+		{RaceGuideOptions{SeriesIDs: []int{10}, Categories: []LicenceCategory{LicenceCategoryRoad}}, []uint64{1, 2}},
+		{RaceGuideOptions{SeriesIDs: []int{20}, Categories: []LicenceCategory{LicenceCategoryRoad}}, nil},
+	}
+
+	for _, test := range tests {
+		opts := test.opts
+		entries, err := raceGuideClient(t).GetRaceGuide(context.Background(), &opts)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var ids []uint64
+
+		for _, e := range entries {
+			ids = append(ids, e.SessionID)
+		}
+
+		if fmt.Sprint(ids) != fmt.Sprint(test.expected) {
+			t.Logf("Expected sessions %v for %+v but got %v", test.expected, test.opts, ids)
+			t.Fail()
+		}
+	}
+}
+
+func TestRaceGuideEntryLink(t *testing.T) {
+	var season Season
+
+	err := json.Unmarshal([]byte(`{
+		"seasonid": 1,
+		"carclasses": [{"id": 5}, {"id": 6}],
+		"tracks": [{"id": 100, "raceweek": 0}, {"id": 101, "raceweek": 0}, {"id": 200, "raceweek": 1}]
+	}`), &season)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A week can have several tracks, so the session's own track is preferred
+	e := RaceGuideEntry{RaceWeek: 0, TrackID: 101, CarClassIDs: []uint{6}}
+	e.link(&season)
+
+	if e.Track != &season.Tracks[1] || len(e.CarClasses) != 1 || e.CarClasses[0].ID != 6 {
+		t.Logf("Expected track 101 and class 6 but got %+v and %+v", e.Track, e.CarClasses)
+		t.Fail()
+	}
+
+	// Without a track or classes, the week's first track and every class are used
+	e = RaceGuideEntry{RaceWeek: 1}
+	e.link(&season)
+
+	if e.Track == nil || e.Track.ID != 200 || len(e.CarClasses) != 2 {
+		t.Logf("Expected track 200 and both classes but got %+v and %+v", e.Track, e.CarClasses)
+		t.Fail()
+	}
+}
@@ -0,0 +1,62 @@
+package irapi
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// rateLimiter spaces out requests so that at most one is sent per interval
+type rateLimiter struct {
+	mu       sync.Mutex
+	interval time.Duration
+	next     time.Time
+}
+
+// wait blocks until the next request may be sent, or the context is done
+func (l *rateLimiter) wait(ctx context.Context) error {
+	l.mu.Lock()
+
+	now := time.Now()
+	at := l.next
+
+	if at.Before(now) {
+		at = now
+	}
+
+	l.next = at.Add(l.interval)
+
+	l.mu.Unlock()
+
+	return sleep(ctx, at.Sub(now))
+}
+
+// SetRateLimit limits the client to sending one request per interval
+//
+// The limit is shared by every call made with the client, including bulk fetches.
+// An interval of zero removes the limit.
+func (c *IRacing) SetRateLimit(interval time.Duration) {
+	if interval <= 0 {
+		c.limiter = nil
+		return
+	}
+
+	c.limiter = &rateLimiter{interval: interval}
+}
+
+// sleep waits for the given duration, or until the context is done
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -20,8 +20,7 @@ func (r *Rating) UnmarshalJSON(s []byte) error {
 	v, err := strconv.ParseInt(str, 10, 64)
 
 	if err != nil {
-		fmt.Printf("Parse Error for '%s', %s\n", str, err)
-		return err
+		return fmt.Errorf("invalid rating %s: %w", str, err)
 	}
 
 	*r = Rating(v)
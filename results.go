@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -86,6 +87,7 @@ type SessionResult struct {
 	DriverChangeRule        int8            `json:"driver_change_rule"`
 	DriverChanges           uint            `json:"driver_changes"`
 	EventType               int8            `json:"evttype"`
+	Official                NumericBool     `json:"officialsession"`
 	LapsComplete            uint            `json:"eventlapscompleted"`
 	LapsForSoloAverage      uint            `json:"nlapsforsoloavg"`
 	LeadChanges             int             `json:"nleadchanges"`
@@ -147,6 +149,8 @@ type LapResult struct {
 }
 
 // CarResult shows the results for a single driver
+//
+// Positions start from zero, so the winner has a FinishPosition of 0.
 type CarResult struct {
 	Name           string `json:"displayname"`
 	CarNumber      string `json:"carnum"`
@@ -156,11 +160,13 @@ type CarResult struct {
 	BestNLapNumber int     `json:"bestnlapnum"`
 	NewCPI         float64 `json:"newcpi"`
 	SessionName    string  `json:"simsesname"`
+	CarClassID     uint    `json:"ccID"`
 	CarClassName   string  `json:"ccName"`
 	OldIRating     int     `json:"oldirating"`
 	NewIRating     int     `json:"newirating"`
-	CarID          uint    `json:"carid"`
+	CarID          uint64  `json:"carid"`
 	LapsCompleted  uint    `json:"lapscomplete"`
+	LapsLed        int     `json:"lapslead"`
 
 	UserID         int          `json:"custid"`
 	Division       int          `json:"division"`
@@ -169,6 +175,21 @@ type CarResult struct {
 	BestLapNumber  int          `json:"bestlapnum"`
 	Incidents      int          `json:"incidents"`
 	OutReason      string       `json:"reasonout"`
+
+	OldLicenceLevel LicenceLevel `json:"oldlicenselevel"`
+	OldSubLevel     int          `json:"oldsublevel"`
+	NewLicenceLevel LicenceLevel `json:"newlicenselevel"`
+	NewSubLevel     int          `json:"newsublevel"`
+}
+
+// OldSafetyRating gets the driver's licence and safety rating before the session
+func (r CarResult) OldSafetyRating() SafetyRating {
+	return r.OldLicenceLevel.SafetyRating(r.OldSubLevel)
+}
+
+// NewSafetyRating gets the driver's licence and safety rating after the session
+func (r CarResult) NewSafetyRating() SafetyRating {
+	return r.NewLicenceLevel.SafetyRating(r.NewSubLevel)
 }
 
 // SearchResultsOptions represents various options which can be given when searching results
@@ -266,8 +287,8 @@ type SearchResultData struct {
 	StartTime              string          `json:"21"`
 	SeasonID               int             `json:"22"`
 	UserID                 uint64          `json:"23"`
-	HelmetLicenceLevel     LicenceClass    `json:"24"`
-	WinnerLicenseLevel     LicenceClass    `json:"25"`
+	HelmetLicenceLevel     LicenceLevel    `json:"24"`
+	WinnerLicenseLevel     LicenceLevel    `json:"25"`
 	RowNumber              int             `json:"26"`
 	WinnersGroupID         int             `json:"27"`
 	SessionRank            int             `json:"28"`
@@ -295,12 +316,14 @@ type SearchResultData struct {
 
 // GetSubSessionResult gets the result of a single iRacing subsession (often referred to as a "split")
 func (c *IRacing) GetSubSessionResult(ctx context.Context, subsessionID uint64) (*SessionResult, error) {
+	ctx, span := c.startSpan(ctx, "GetSubSessionResult", "irapi.subsession_id", subsessionID)
+	defer span.End()
 
 	path := "/membersite/member/GetSubsessionResults?subsessionID=" + strconv.FormatUint(subsessionID, 10)
 	result := &SessionResult{}
 
 	if err := c.json(ctx, http.MethodGet, path, nil, result); err != nil {
-		return nil, err
+		return nil, span.fail(err)
 	}
 
 	return result, nil
@@ -310,11 +333,13 @@ func (c *IRacing) GetSubSessionResult(ctx context.Context, subsessionID uint64)
 //
 // @param userID ID of a user who participated in the session
 func (c *IRacing) SearchResults(ctx context.Context, opts *SearchResultsOptions) ([]SearchResultData, error) {
+	ctx, span := c.startSpan(ctx, "SearchResults", "irapi.cust_id", opts.UserID)
+	defer span.End()
 
 	if opts.DateRange != nil && opts.Season != nil {
-		return nil, errors.New("only one of Season or DateRange may be specified")
+		return nil, span.fail(errors.New("only one of Season or DateRange may be specified"))
 	} else if opts.DateRange == nil && opts.Season == nil {
-		return nil, errors.New("one of DateRange or Season must be specified")
+		return nil, span.fail(errors.New("one of DateRange or Season must be specified"))
 	}
 
 	path := "/memberstats/member/GetResults"
@@ -365,13 +390,16 @@ func (c *IRacing) SearchResults(ctx context.Context, opts *SearchResultsOptions)
 	resp := &searchResultsResponse{}
 
 	if err := c.json(ctx, http.MethodGet, path, nil, resp); err != nil {
-		return nil, err
+		return nil, span.fail(err)
 	}
 
 	return resp.Data.Rows, nil
 }
 
 func (c *IRacing) GetLaps(ctx context.Context, sessionID uint64, entrantID int64, phase int64) ([]LapResult, error) {
+	ctx, span := c.startSpan(ctx, "GetLaps", "irapi.subsession_id", sessionID, "irapi.entrant_id", entrantID)
+	defer span.End()
+
 	path := "/membersite/member/GetLaps"
 
 	params := make(url.Values)
@@ -384,7 +412,7 @@ func (c *IRacing) GetLaps(ctx context.Context, sessionID uint64, entrantID int64
 	resp := &getLapTimesResponse{}
 
 	if err := c.json(ctx, http.MethodPost, path, strings.NewReader("a=null"), resp); err != nil {
-		return nil, err
+		return nil, span.fail(err)
 	}
 
 	laps := resp.Laptimes
@@ -400,3 +428,93 @@ func (c *IRacing) GetLaps(ctx context.Context, sessionID uint64, entrantID int64
 
 	return laps, nil
 }
+
+// SeriesRaceResult is a summary of a single subsession of a series race week
+type SeriesRaceResult struct {
+	StartTime    Timestamp   `json:"1"`
+	CarClassID   uint64      `json:"2"`
+	TrackID      uint64      `json:"3"`
+	SessionID    uint64      `json:"4"`
+	SubsessionID uint64      `json:"5"`
+	Official     NumericBool `json:"6"`
+	FieldSize    int         `json:"7"`
+	SOF          int         `json:"8"`
+	WinnerID     uint64      `json:"9"`
+	WinnerName   string      `json:"10"`
+
+	// Split is the subsession's split number within its session, 1 being the highest SOF.
+	// Multi-class subsessions have a result for each class, which all share the same split.
+	Split int `json:"-"`
+}
+
+type seriesRaceResultsResponse struct {
+	Headers map[string]string  `json:"m"`
+	Data    []SeriesRaceResult `json:"d"`
+}
+
+// GetSeriesRaceResults gets every subsession which ran in a race week of a series season, in start time order
+//
+// Use GetSubSessionResult with the SubsessionID for the full results.
+func (c *IRacing) GetSeriesRaceResults(ctx context.Context, seasonID, raceWeek int) ([]SeriesRaceResult, error) {
+	ctx, span := c.startSpan(ctx, "GetSeriesRaceResults", "irapi.season_id", seasonID, "irapi.race_week", raceWeek)
+	defer span.End()
+
+	params := make(url.Values)
+	params.Set("seasonid", strconv.Itoa(seasonID))
+	params.Set("raceweek", strconv.Itoa(raceWeek))
+
+	path := "/memberstats/member/GetSeriesRaceResults?" + params.Encode()
+
+	resp := &seriesRaceResultsResponse{}
+
+	if err := c.json(ctx, http.MethodGet, path, nil, resp); err != nil {
+		return nil, span.fail(err)
+	}
+
+	// The decoded rows are copied before being sorted and numbered, so they are never changed in place
+	results := append([]SeriesRaceResult(nil), resp.Data...)
+
+	// Each class of a multi-class subsession has its own SOF, so splits are ordered by the highest of them
+	sof := make(map[uint64]int)
+
+	for _, r := range results {
+		if r.SOF > sof[r.SubsessionID] {
+			sof[r.SubsessionID] = r.SOF
+		}
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		a, b := results[i], results[j]
+
+		if ta, tb := time.Time(a.StartTime), time.Time(b.StartTime); !ta.Equal(tb) {
+			return ta.Before(tb)
+		}
+
+		if a.SessionID != b.SessionID {
+			return a.SessionID < b.SessionID
+		}
+
+		if sof[a.SubsessionID] != sof[b.SubsessionID] {
+			return sof[a.SubsessionID] > sof[b.SubsessionID]
+		}
+
+		if a.SubsessionID != b.SubsessionID {
+			return a.SubsessionID < b.SubsessionID
+		}
+
+		return a.CarClassID < b.CarClassID
+	})
+
+	// Splits are numbered by SOF within each session, which the sort has already ordered them by
+	splits := make(map[uint64]int)
+
+	for i := range results {
+		if i == 0 || results[i].SubsessionID != results[i-1].SubsessionID {
+			splits[results[i].SessionID]++
+		}
+
+		results[i].Split = splits[results[i].SessionID]
+	}
+
+	return results, nil
+}
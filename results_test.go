@@ -0,0 +1,77 @@
+package irapi
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestGetSeriesRaceResults(t *testing.T) {
+	api := testClient(func(req *http.Request) string {
+		if req.URL.Path != "/memberstats/member/GetSeriesRaceResults" {
+			t.Errorf("Unexpected request to %s", req.URL.Path)
+		}
+
+		// Subsession 12 is multi-class, so it has a result for each class
+		return `{"m":{},"d":[
+			{"1":1600000000000,"2":1,"4":2,"5":20,"6":1,"8":1500},
+			{"1":1600000000000,"2":1,"4":2,"5":12,"6":1,"8":1800},
+			{"1":1600000000000,"2":2,"4":2,"5":12,"6":1,"8":2500},
+			{"1":1600000000000,"2":1,"4":2,"5":11,"6":0,"8":2000},
+			{"1":1590000000000,"2":1,"4":1,"5":10,"6":1,"8":1000}
+		]}`
+	})
+
+	results, err := api.GetSeriesRaceResults(context.Background(), 3000, 1)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []struct {
+		subsessionID uint64
+		carClassID   uint64
+		split        int
+	}{
+		{10, 1, 1},
+		{12, 1, 1},
+		{12, 2, 1},
+		{11, 1, 2},
+		{20, 1, 3},
+	}
+
+	if len(results) != len(expected) {
+		t.Fatalf("Expected %d results but got %d", len(expected), len(results))
+	}
+
+	for i, e := range expected {
+		r := results[i]
+
+		if r.SubsessionID != e.subsessionID || r.CarClassID != e.carClassID || r.Split != e.split {
+			t.Logf("Expected subsession %d class %d to be split %d at %d but got %+v", e.subsessionID, e.carClassID, e.split, i, r)
+			t.Fail()
+		}
+	}
+
+	if results[3].Official || !results[4].Official {
+		t.Log("Expected only subsession 11 to be unofficial")
+		t.Fail()
+	}
+}
+
+func TestGetSeriesRaceResultsConcurrently(t *testing.T) {
+	api, release := heldClient(func(req *http.Request) string {
+		return `{"m":{},"d":[
+			{"1":1600000000000,"4":2,"5":20,"8":1500},
+			{"1":1600000000000,"4":2,"5":11,"8":2000}
+		]}`
+	})
+
+	callCoalesced(api, release, 2, func() {
+		results, err := api.GetSeriesRaceResults(context.Background(), 3000, 1)
+
+		if err != nil || len(results) != 2 || results[0].SubsessionID != 11 || results[1].Split != 2 {
+			t.Errorf("Expected subsessions 11 then 20 as splits 1 and 2 but got %+v (%v)", results, err)
+		}
+	})
+}
@@ -0,0 +1,76 @@
+package irapi
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// SetRetries sets how many times a request is retried after iRacing responds with 429 Too Many Requests
+//
+// Retries wait for the time given by the Retry-After header, or back off exponentially from one second.
+func (c *IRacing) SetRetries(retries int) {
+	c.retries = retries
+}
+
+// retryDelay gets how long to wait before retrying a throttled request
+func retryDelay(res *http.Response, attempt int) time.Duration {
+	if seconds, err := strconv.Atoi(res.Header.Get("Retry-After")); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second
+	}
+
+	return time.Second << uint(attempt)
+}
+
+// roundTrip sends a request, retrying it if it is throttled
+//
+// This is the innermost Handler of the middleware chain.
+func (c *IRacing) roundTrip(ctx context.Context, req *http.Request) (*http.Response, error) {
+	start := time.Now()
+
+	for attempt := 0; ; attempt++ {
+		res, err := c.attempt(ctx, req, attempt)
+
+		if err != ErrTooManyRequests || attempt >= c.retries {
+			c.logRequest(req, res, err, time.Since(start), attempt)
+			return res, err
+		}
+
+		delay := retryDelay(res, attempt)
+		res.Body.Close()
+
+		c.metrics.Retry(req.URL.Path)
+
+		c.logger.Warn("request throttled, retrying",
+			"method", req.Method,
+			"path", req.URL.Path,
+			"retries", attempt,
+			"delay", delay,
+		)
+
+		if err := sleep(ctx, delay); err != nil {
+			return nil, err
+		}
+
+		if req.GetBody != nil {
+			if req.Body, err = req.GetBody(); err != nil {
+				return nil, err
+			}
+		}
+	}
+}
+
+// attempt sends a request, tracing it as a retry if it isn't the first attempt
+func (c *IRacing) attempt(ctx context.Context, req *http.Request, attempt int) (*http.Response, error) {
+	if attempt == 0 {
+		return c.send(ctx, req)
+	}
+
+	ctx, span := c.startSpan(ctx, "retry", "irapi.retry", attempt)
+	defer span.End()
+
+	res, err := c.send(ctx, req.WithContext(ctx))
+
+	return res, span.fail(err)
+}
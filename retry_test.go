@@ -0,0 +1,56 @@
+package irapi
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"testing"
+)
+
+// throttlingClient creates a client whose first `throttled` requests are rejected with 429 Too Many Requests
+func throttlingClient(throttled int32) (*IRacing, *int32) {
+	var requests int32
+
+	api := New(StaticCredentialsProvider("", ""))
+	api.SetHTTP(&http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		res := jsonResponse(req, `[]`)
+
+		if atomic.AddInt32(&requests, 1) <= throttled {
+			res.StatusCode = http.StatusTooManyRequests
+			res.Header.Set("Retry-After", "0")
+		}
+
+		return res, nil
+	})})
+
+	return api, &requests
+}
+
+func TestRetriesThrottledRequests(t *testing.T) {
+	api, requests := throttlingClient(2)
+	api.SetRetries(2)
+
+	if _, err := api.GetCars(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	if *requests != 3 {
+		t.Logf("Expected 3 upstream requests but got %d", *requests)
+		t.Fail()
+	}
+}
+
+func TestRetriesGiveUp(t *testing.T) {
+	api, requests := throttlingClient(2)
+	api.SetRetries(1)
+
+	if _, err := api.GetCars(context.Background()); err != ErrTooManyRequests {
+		t.Logf("Expected ErrTooManyRequests but got %v", err)
+		t.Fail()
+	}
+
+	if *requests != 2 {
+		t.Logf("Expected 2 upstream requests but got %d", *requests)
+		t.Fail()
+	}
+}
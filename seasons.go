@@ -3,6 +3,7 @@ package irapi
 import (
 	"context"
 	"net/http"
+	"time"
 )
 
 type Season struct {
@@ -26,8 +27,8 @@ type Season struct {
 }
 
 type SeasonTrack struct {
-	ID            uint   `json:"id"`
-	PackageID     uint   `json:"pkgid"`
+	ID            uint64 `json:"id"`
+	PackageID     uint64 `json:"pkgid"`
 	Configuration string `json:"config"`
 	Name          string `json:"name"`
 
@@ -42,7 +43,7 @@ type CarClass struct {
 	ShortName     string `json:"shortname"`
 	RelativeSpeed int    `json:"relspeed"`
 	Cars          []struct {
-		ID   uint   `json:"id"`
+		ID   uint64 `json:"id"`
 		Name string `json:"name"`
 	} `json:"cars"`
 }
@@ -50,6 +51,9 @@ type CarClass struct {
 type SeasonList []Season
 
 func (c *IRacing) GetSeasons(ctx context.Context, onlyActive bool) (SeasonList, error) {
+	ctx, span := c.startSpan(ctx, "GetSeasons", "irapi.only_active", onlyActive)
+	defer span.End()
+
 	path := "/membersite/member/GetSeasons?fields=year,quarter,seriesid,active,catid,carclasses," +
 		"tracks,start,end,cars,raceweek,category,serieslicgroupid,names_arr,seasonid,carid" +
 		",seriesshortname" +
@@ -66,7 +70,7 @@ func (c *IRacing) GetSeasons(ctx context.Context, onlyActive bool) (SeasonList,
 	err := c.json(ctx, http.MethodGet, path, nil, &seasons)
 
 	if err != nil {
-		return nil, err
+		return nil, span.fail(err)
 	}
 
 	return seasons, nil
@@ -77,21 +81,153 @@ func (l SeasonList) Len() int { return len(l) }
 func (l SeasonList) Less(i, j int) bool {
 	a, b := l[i], l[j]
 
-	if a.Year < b.Year {
-		return true
+	if a.Year != b.Year {
+		return a.Year < b.Year
+	}
+
+	if a.Quarter != b.Quarter {
+		return a.Quarter < b.Quarter
+	}
+
+	return a.Week < b.Week
+}
+
+func (l SeasonList) Swap(i, j int) {
+	l[i], l[j] = l[j], l[i]
+}
+
+// raceWeekLength is how long each race week of a season lasts
+const raceWeekLength = 7 * 24 * time.Hour
+
+// WeekAt gets the race week of the season at the given time, if the season is running then
+func (s Season) WeekAt(t time.Time) (int, bool) {
+	start, end := time.Time(s.Start), time.Time(s.End)
+
+	if t.Before(start) || !t.Before(end) {
+		return 0, false
 	}
 
-	if a.Year == b.Year && a.Quarter < b.Quarter {
-		return true
+	return int(t.Sub(start) / raceWeekLength), true
+}
+
+// TrackForWeek gets the season's track for a race week
+func (s *Season) TrackForWeek(week int) (*SeasonTrack, bool) {
+	for i := range s.Tracks {
+		if s.Tracks[i].RaceWeek == week {
+			return &s.Tracks[i], true
+		}
 	}
 
-	if a.Quarter == b.Quarter && a.Week < b.Week {
-		return true
+	return nil, false
+}
+
+// HasCar reports whether any of the season's car classes include the car
+func (s Season) HasCar(carID uint64) bool {
+	for _, cc := range s.CarClasses {
+		for _, car := range cc.Cars {
+			if car.ID == carID {
+				return true
+			}
+		}
 	}
 
 	return false
 }
 
-func (l SeasonList) Swap(i, j int) {
-	l[i], l[j] = l[j], l[i]
+// filter gets the seasons for which f returns true
+func (l SeasonList) filter(f func(s *Season) bool) SeasonList {
+	var out SeasonList
+
+	for i := range l {
+		if f(&l[i]) {
+			out = append(out, l[i])
+		}
+	}
+
+	return out
+}
+
+// ByCategory gets the seasons in a licence category
+func (l SeasonList) ByCategory(category LicenceCategory) SeasonList {
+	return l.filter(func(s *Season) bool { return s.Category == category })
+}
+
+// ByLicenceClass gets the seasons requiring a licence class
+func (l SeasonList) ByLicenceClass(class LicenceClass) SeasonList {
+	return l.filter(func(s *Season) bool { return s.LicenceGroup == class })
+}
+
+// BySeries gets the seasons of a series
+func (l SeasonList) BySeries(seriesID int) SeasonList {
+	return l.filter(func(s *Season) bool { return s.SeriesID == seriesID })
+}
+
+// SeriesRunningAt gets the seasons which race at a track in any week
+func (l SeasonList) SeriesRunningAt(trackID uint64) SeasonList {
+	return l.filter(func(s *Season) bool {
+		for _, t := range s.Tracks {
+			if t.ID == trackID {
+				return true
+			}
+		}
+
+		return false
+	})
+}
+
+// SeasonsWithCar gets the seasons in which a car can be raced
+func (l SeasonList) SeasonsWithCar(carID uint64) SeasonList {
+	return l.filter(func(s *Season) bool { return s.HasCar(carID) })
+}
+
+// Season gets the season with the given ID
+func (l SeasonList) Season(seasonID int) (*Season, bool) {
+	for i := range l {
+		if l[i].SeasonID == seasonID {
+			return &l[i], true
+		}
+	}
+
+	return nil, false
+}
+
+// TrackForWeek gets the track a season races at in a race week
+func (l SeasonList) TrackForWeek(seasonID, week int) (*SeasonTrack, bool) {
+	season, ok := l.Season(seasonID)
+
+	if !ok {
+		return nil, false
+	}
+
+	return season.TrackForWeek(week)
+}
+
+// SeasonWeek is a race week of a season, with the track raced at that week
+type SeasonWeek struct {
+	Season   *Season
+	RaceWeek int
+	Track    *SeasonTrack
+}
+
+// CurrentWeek gets the race week, and its track, of every season running at the given time
+func (l SeasonList) CurrentWeek(now time.Time) []SeasonWeek {
+	var weeks []SeasonWeek
+
+	for i := range l {
+		week, ok := l[i].WeekAt(now)
+
+		if !ok {
+			continue
+		}
+
+		track, _ := l[i].TrackForWeek(week)
+
+		weeks = append(weeks, SeasonWeek{
+			Season:   &l[i],
+			RaceWeek: week,
+			Track:    track,
+		})
+	}
+
+	return weeks
 }
@@ -0,0 +1,61 @@
+package irapi
+
+import (
+	"sort"
+	"testing"
+	"time"
+)
+
+func TestSeasonListSort(t *testing.T) {
+	seasons := SeasonList{
+		{SeasonID: 3, Year: 2021, Quarter: 1, Week: 2},
+		{SeasonID: 2, Year: 2020, Quarter: 4, Week: 11},
+		{SeasonID: 1, Year: 2020, Quarter: 4, Week: 3},
+	}
+
+	sort.Sort(seasons)
+
+	for i, s := range seasons {
+		if s.SeasonID != i+1 {
+			t.Logf("Expected season %d at position %d but got %d", i+1, i, s.SeasonID)
+			t.Fail()
+		}
+	}
+}
+
+func TestSeasonListCurrentWeek(t *testing.T) {
+	start := time.Date(2020, 12, 8, 0, 0, 0, 0, time.UTC)
+
+	seasons := SeasonList{
+		{
+			SeasonID: 1,
+			Start:    Timestamp(start),
+			End:      Timestamp(start.Add(12 * raceWeekLength)),
+			Tracks: []SeasonTrack{
+				{ID: 10, RaceWeek: 0},
+				{ID: 20, RaceWeek: 1},
+			},
+		},
+		{
+			SeasonID: 2,
+			Start:    Timestamp(start.Add(12 * raceWeekLength)),
+			End:      Timestamp(start.Add(24 * raceWeekLength)),
+		},
+	}
+
+	weeks := seasons.CurrentWeek(start.Add(raceWeekLength + time.Hour))
+
+	if len(weeks) != 1 {
+		t.Fatalf("Expected 1 running season but got %d", len(weeks))
+	}
+
+	if w := weeks[0]; w.Season.SeasonID != 1 || w.RaceWeek != 1 || w.Track == nil || w.Track.ID != 20 {
+		t.Logf("Expected season 1 to be at track 20 in week 1 but got %+v", w)
+		t.Fail()
+	}
+
+	if track, ok := seasons.TrackForWeek(1, 0); !ok || track.ID != 10 {
+		t.Logf("Expected track 10 in week 0 but got %+v", track)
+		t.Fail()
+	}
+}
@@ -0,0 +1,138 @@
+package irapi
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// SeasonStanding is a single member's position in the championship table of a series season
+type SeasonStanding struct {
+	Position     int    `json:"1"`
+	UserID       uint64 `json:"2"`
+	DisplayName  string `json:"3"`
+	ClubID       int    `json:"4"`
+	ClubName     string `json:"5"`
+	Division     int    `json:"6"`
+	Points       int    `json:"7"`
+	Starts       int    `json:"8"`
+	Wins         int    `json:"9"`
+	Incidents    int    `json:"10"`
+	WeeksCounted int    `json:"11"`
+	RowNumber    int    `json:"12"`
+}
+
+// SeasonStandingsOptions represents the filters which can be given when getting season standings
+type SeasonStandingsOptions struct {
+	// ClubID limits the standings to a single club, zero includes every club
+	ClubID int
+
+	// Division limits the standings to a single division, nil includes every division
+	Division *int
+
+	// RaceWeek limits the standings to a single race week, nil includes the whole season
+	RaceWeek *int
+
+	// PageSize is the number of rows fetched per request, defaulting to DefaultPageSize
+	PageSize int
+}
+
+type seasonStandingsResponse struct {
+	Headers map[string]string `json:"m"`
+	Data    struct {
+		Count int              `json:"rowcount"`
+		Rows  []SeasonStanding `json:"r"`
+	} `json:"d"`
+}
+
+// SeasonStandingsIterator iterates over the rows of a season's standings, fetching pages as needed
+type SeasonStandingsIterator struct {
+	pager
+	rows []SeasonStanding
+}
+
+// Next gets the next row of the standings, or ErrIteratorDone once every row has been returned
+func (it *SeasonStandingsIterator) Next() (*SeasonStanding, error) {
+	for len(it.rows) == 0 {
+		if err := it.nextPage(); err != nil {
+			return nil, err
+		}
+	}
+
+	row := it.rows[0]
+	it.rows = it.rows[1:]
+
+	return &row, nil
+}
+
+// All gets every remaining row of the standings
+func (it *SeasonStandingsIterator) All() ([]SeasonStanding, error) {
+	var rows []SeasonStanding
+
+	for {
+		row, err := it.Next()
+
+		if err == ErrIteratorDone {
+			return rows, nil
+		}
+
+		if err != nil {
+			return rows, err
+		}
+
+		rows = append(rows, *row)
+	}
+}
+
+// GetSeasonStandings gets the championship table for a car class in a series season, best placed first
+//
+// Pages of the table are only fetched as the iterator reaches them. opts may be nil.
+func (c *IRacing) GetSeasonStandings(ctx context.Context, seasonID, carClassID int, opts *SeasonStandingsOptions) *SeasonStandingsIterator {
+	if opts == nil {
+		opts = &SeasonStandingsOptions{}
+	}
+
+	params := make(url.Values)
+	params.Set("seasonid", strconv.Itoa(seasonID))
+	params.Set("carclassid", strconv.Itoa(carClassID))
+	params.Set("clubid", "-1")
+	params.Set("division", "-1")
+	params.Set("raceweek", "-1")
+	params.Set("sort", "points")
+	params.Set("order", "desc")
+
+	if opts.ClubID > 0 {
+		params.Set("clubid", strconv.Itoa(opts.ClubID))
+	}
+
+	if opts.Division != nil {
+		params.Set("division", strconv.Itoa(*opts.Division))
+	}
+
+	if opts.RaceWeek != nil {
+		params.Set("raceweek", strconv.Itoa(*opts.RaceWeek))
+	}
+
+	it := &SeasonStandingsIterator{}
+
+	it.pager = newPager(ctx, opts.PageSize, func(ctx context.Context, lower, upper int) (int, int, error) {
+		ctx, span := c.startSpan(ctx, "GetSeasonStandings", "irapi.season_id", seasonID, "irapi.car_class_id", carClassID)
+		defer span.End()
+
+		params.Set("start", strconv.Itoa(lower))
+		params.Set("end", strconv.Itoa(upper))
+
+		resp := &seasonStandingsResponse{}
+
+		if err := c.json(ctx, http.MethodGet, "/memberstats/member/GetSeasonStandings?"+params.Encode(), nil, resp); err != nil {
+			return 0, 0, span.fail(err)
+		}
+
+		it.rows = append(it.rows, resp.Data.Rows...)
+
+		return len(resp.Data.Rows), resp.Data.Count, nil
+	})
+
+	return it
+}
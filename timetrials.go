@@ -0,0 +1,140 @@
+package irapi
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// TimeTrialResult is a single member's row of a time trial or time attack leaderboard
+type TimeTrialResult struct {
+	Position    int     `json:"1"`
+	UserID      uint64  `json:"2"`
+	DisplayName string  `json:"3"`
+	ClubID      int     `json:"4"`
+	ClubName    string  `json:"5"`
+	Division    int     `json:"6"`
+	BestTime    Laptime `json:"7"`
+	Points      int     `json:"8"`
+	Starts      int     `json:"9"`
+	RowNumber   int     `json:"10"`
+}
+
+// LeaderboardOptions represents the filters which can be given when getting a leaderboard
+type LeaderboardOptions struct {
+	// ClubID limits the leaderboard to a single club, zero includes every club
+	ClubID int
+
+	// Division limits the leaderboard to a single division, nil includes every division
+	Division *int
+
+	// PageSize is the number of rows fetched per request, defaulting to DefaultPageSize
+	PageSize int
+}
+
+type timeTrialResultsResponse struct {
+	Headers map[string]string `json:"m"`
+	Data    struct {
+		Count int               `json:"rowcount"`
+		Rows  []TimeTrialResult `json:"r"`
+	} `json:"d"`
+}
+
+// TimeTrialResultsIterator iterates over the rows of a leaderboard, fetching pages as needed
+type TimeTrialResultsIterator struct {
+	pager
+	rows []TimeTrialResult
+}
+
+// Next gets the next row of the leaderboard, or ErrIteratorDone once every row has been returned
+func (it *TimeTrialResultsIterator) Next() (*TimeTrialResult, error) {
+	for len(it.rows) == 0 {
+		if err := it.nextPage(); err != nil {
+			return nil, err
+		}
+	}
+
+	row := it.rows[0]
+	it.rows = it.rows[1:]
+
+	return &row, nil
+}
+
+// All gets every remaining row of the leaderboard
+func (it *TimeTrialResultsIterator) All() ([]TimeTrialResult, error) {
+	var rows []TimeTrialResult
+
+	for {
+		row, err := it.Next()
+
+		if err == ErrIteratorDone {
+			return rows, nil
+		}
+
+		if err != nil {
+			return rows, err
+		}
+
+		rows = append(rows, *row)
+	}
+}
+
+// GetTimeTrialResults gets the time trial leaderboard for a car class in a race week of a series season, fastest first
+//
+// Pages of the leaderboard are only fetched as the iterator reaches them. opts may be nil.
+func (c *IRacing) GetTimeTrialResults(ctx context.Context, seasonID, carClassID, raceWeek int, opts *LeaderboardOptions) *TimeTrialResultsIterator {
+	return c.leaderboard(ctx, "GetTimeTrialResults", "/memberstats/member/GetSeasonTTResults", seasonID, carClassID, raceWeek, opts)
+}
+
+// GetTimeAttackResults gets the time attack leaderboard for a car class in a race week of a series season, fastest first
+//
+// Pages of the leaderboard are only fetched as the iterator reaches them. opts may be nil.
+func (c *IRacing) GetTimeAttackResults(ctx context.Context, seasonID, carClassID, raceWeek int, opts *LeaderboardOptions) *TimeTrialResultsIterator {
+	return c.leaderboard(ctx, "GetTimeAttackResults", "/memberstats/member/GetSeasonTAResults", seasonID, carClassID, raceWeek, opts)
+}
+
+func (c *IRacing) leaderboard(ctx context.Context, name, path string, seasonID, carClassID, raceWeek int, opts *LeaderboardOptions) *TimeTrialResultsIterator {
+	if opts == nil {
+		opts = &LeaderboardOptions{}
+	}
+
+	params := make(url.Values)
+	params.Set("seasonid", strconv.Itoa(seasonID))
+	params.Set("carclassid", strconv.Itoa(carClassID))
+	params.Set("raceweek", strconv.Itoa(raceWeek))
+	params.Set("clubid", "-1")
+	params.Set("division", "-1")
+	params.Set("sort", "points")
+	params.Set("order", "desc")
+
+	if opts.ClubID > 0 {
+		params.Set("clubid", strconv.Itoa(opts.ClubID))
+	}
+
+	if opts.Division != nil {
+		params.Set("division", strconv.Itoa(*opts.Division))
+	}
+
+	it := &TimeTrialResultsIterator{}
+
+	it.pager = newPager(ctx, opts.PageSize, func(ctx context.Context, lower, upper int) (int, int, error) {
+		ctx, span := c.startSpan(ctx, name, "irapi.season_id", seasonID, "irapi.car_class_id", carClassID, "irapi.race_week", raceWeek)
+		defer span.End()
+
+		params.Set("start", strconv.Itoa(lower))
+		params.Set("end", strconv.Itoa(upper))
+
+		resp := &timeTrialResultsResponse{}
+
+		if err := c.json(ctx, http.MethodGet, path+"?"+params.Encode(), nil, resp); err != nil {
+			return 0, 0, span.fail(err)
+		}
+
+		it.rows = append(it.rows, resp.Data.Rows...)
+
+		return len(resp.Data.Rows), resp.Data.Count, nil
+	})
+
+	return it
+}
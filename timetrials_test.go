@@ -0,0 +1,115 @@
+package irapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// leaderboardClient creates a client with a leaderboard of `total` rows, failing the first request for each of `failing` pages
+func leaderboardClient(t *testing.T, path string, total int, failing ...int) (*IRacing, *[]string) {
+	var queries []string
+	failed := make(map[int]bool)
+
+	api := New(StaticCredentialsProvider("", ""))
+	api.SetHTTP(&http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.URL.Path != path {
+			t.Errorf("Expected a request to %s but got %s", path, req.URL.Path)
+		}
+
+		queries = append(queries, req.URL.RawQuery)
+
+		lower, _ := strconv.Atoi(req.URL.Query().Get("start"))
+		upper, _ := strconv.Atoi(req.URL.Query().Get("end"))
+
+		for _, f := range failing {
+			if f == lower && !failed[f] {
+				failed[f] = true
+
+				res := jsonResponse(req, ``)
+				res.StatusCode = http.StatusInternalServerError
+				return res, nil
+			}
+		}
+
+		var rows []string
+
+		for i := lower; i <= upper && i <= total; i++ {
+			rows = append(rows, fmt.Sprintf(`{"1":%d,"2":%d,"7":"1:%02d.000"}`, i, 100+i, i))
+		}
+
+		return jsonResponse(req, fmt.Sprintf(`{"m":{},"d":{"rowcount":%d,"r":[%s]}}`, total, strings.Join(rows, ","))), nil
+	})})
+
+	return api, &queries
+}
+
+func TestGetTimeTrialResults(t *testing.T) {
+	api, queries := leaderboardClient(t, "/memberstats/member/GetSeasonTTResults", 3)
+
+	division := 2
+	it := api.GetTimeTrialResults(context.Background(), 3000, 5, 1, &LeaderboardOptions{ClubID: 7, Division: &division, PageSize: 2})
+
+	rows, err := it.All()
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(rows) != 3 {
+		t.Fatalf("Expected 3 rows but got %d", len(rows))
+	}
+
+	for i, r := range rows {
+		if r.Position != i+1 || r.UserID != uint64(101+i) || r.BestTime.String() != fmt.Sprintf("1:%02d.000", i+1) {
+			t.Logf("Unexpected row %d %+v", i, r)
+			t.Fail()
+		}
+	}
+
+	if len(*queries) != 2 {
+		t.Logf("Expected 2 pages to be fetched but got %d", len(*queries))
+		t.Fail()
+	}
+
+	for _, param := range []string{"seasonid=3000", "carclassid=5", "raceweek=1", "clubid=7", "division=2"} {
+		if !strings.Contains((*queries)[0], param) {
+			t.Logf("Expected %s in the query %s", param, (*queries)[0])
+			t.Fail()
+		}
+	}
+}
+
+func TestGetTimeAttackResultsRetriesFailedPage(t *testing.T) {
+	api, queries := leaderboardClient(t, "/memberstats/member/GetSeasonTAResults", 3, 3)
+
+	it := api.GetTimeAttackResults(context.Background(), 3000, 5, 1, &LeaderboardOptions{PageSize: 2})
+
+	for i := 1; i <= 2; i++ {
+		if row, err := it.Next(); err != nil || row.Position != i {
+			t.Fatalf("Expected row %d but got %+v (%v)", i, row, err)
+		}
+	}
+
+	if _, err := it.Next(); err == nil || err == ErrIteratorDone {
+		t.Fatalf("Expected the second page to fail but got %v", err)
+	}
+
+	// The failed page is fetched again
+	if row, err := it.Next(); err != nil || row.Position != 3 {
+		t.Fatalf("Expected row 3 after retrying but got %+v (%v)", row, err)
+	}
+
+	if _, err := it.Next(); err != ErrIteratorDone {
+		t.Logf("Expected ErrIteratorDone but got %v", err)
+		t.Fail()
+	}
+
+	if !strings.Contains((*queries)[0], "clubid=-1") || !strings.Contains((*queries)[0], "division=-1") {
+		t.Logf("Expected every club and division by default but got %s", (*queries)[0])
+		t.Fail()
+	}
+}
@@ -0,0 +1,80 @@
+package irapi
+
+import "context"
+
+// Tracer starts spans which trace the client's calls to iRacing
+//
+// Every public call opens a span, with child spans for logging in, each request
+// (and each retry of it), and decoding the response.
+// An adapter for OpenTelemetry is available in the separate `irapiotel` module.
+type Tracer interface {
+	// Start starts a span as a child of any span in ctx, returning a context carrying the new span
+	Start(ctx context.Context, name string) (context.Context, Span)
+}
+
+// Span is a single traced operation
+type Span interface {
+	// SetAttribute sets an attribute describing the operation
+	SetAttribute(key string, value interface{})
+
+	// RecordError records the error which the operation failed with
+	RecordError(err error)
+
+	// End ends the span
+	End()
+
+	// TraceParent gets the W3C `traceparent` header value identifying the span,
+	// or an empty string if it has none.
+	TraceParent() string
+}
+
+// SetTracer sets the tracer used to trace the client's calls
+//
+// The `traceparent` header is set on each request before any BeforeFunc is run.
+func (c *IRacing) SetTracer(t Tracer) {
+	if t == nil {
+		t = nopTracer{}
+	}
+
+	c.tracer = t
+}
+
+// span wraps a Span so errors can be recorded as they are returned
+type span struct {
+	Span
+}
+
+// startSpan starts a span for a client operation, with alternating attribute keys and values
+func (c *IRacing) startSpan(ctx context.Context, name string, attrs ...interface{}) (context.Context, span) {
+	ctx, s := c.tracer.Start(ctx, "irapi."+name)
+
+	for i := 0; i+1 < len(attrs); i += 2 {
+		if key, ok := attrs[i].(string); ok {
+			s.SetAttribute(key, attrs[i+1])
+		}
+	}
+
+	return ctx, span{s}
+}
+
+// fail records err on the span, if there is one, and returns it
+func (s span) fail(err error) error {
+	if err != nil {
+		s.RecordError(err)
+	}
+
+	return err
+}
+
+type nopTracer struct{}
+
+func (nopTracer) Start(ctx context.Context, _ string) (context.Context, Span) {
+	return ctx, nopSpan{}
+}
+
+type nopSpan struct{}
+
+func (nopSpan) SetAttribute(string, interface{}) {}
+func (nopSpan) RecordError(error)                {}
+func (nopSpan) End()                             {}
+func (nopSpan) TraceParent() string              { return "" }